@@ -0,0 +1,136 @@
+package game
+
+import "image"
+
+// Attract mode runs on the start screen when nobody is playing: after the
+// title has been idle for a while it introduces the ghosts, then shows a
+// demo game played by the autopilot, then returns to the title. It is
+// purely a frontend loop; the real Sim stays on StateStart throughout.
+type attractPhase int
+
+const (
+	attractTitle  attractPhase = iota // Static start screen
+	attractRoster                     // Ghost roster introduction
+	attractDemo                       // Demo game played by the autopilot
+)
+
+const (
+	attractIdleTicks = 10 * TicksPerSecond // Title shown before the roster starts
+	rosterStepTicks  = TicksPerSecond      // Between each reveal on the roster
+	rosterTicks      = 12 * rosterStepTicks
+	demoMaxTicks     = 60 * TicksPerSecond // Demo games end after this at the latest
+)
+
+type attractMode struct {
+	phase attractPhase
+	ticks int  // Ticks spent in the current phase
+	demo  *Sim // Demo game, during attractDemo
+}
+
+// Each ghost's character name and nickname, as on the arcade's roster
+var ghostNames = map[GhostType][2]string{
+	GhostBlinky: {"SHADOW", "BLINKY"},
+	GhostPinky:  {"SPEEDY", "PINKY"},
+	GhostInky:   {"BASHFUL", "INKY"},
+	GhostClyde:  {"POKEY", "CLYDE"},
+}
+
+// setPhase moves attract mode to phase and restarts its timer.
+func (a *attractMode) setPhase(phase attractPhase) {
+	a.phase = phase
+	a.ticks = 0
+	a.demo = nil
+}
+
+// updateAttract advances attract mode by one tick while the Sim is on the
+// start screen.
+func (g *Game) updateAttract() {
+	a := &g.attract
+	a.ticks++
+
+	switch a.phase {
+	case attractTitle:
+		if a.ticks >= attractIdleTicks {
+			a.setPhase(attractRoster)
+			g.clearScreen()
+		}
+
+	case attractRoster:
+		g.renderRoster(a.ticks)
+		if a.ticks >= rosterTicks {
+			a.setPhase(attractDemo)
+			a.demo = NewSim(g.sim.Seed(), g.sim.rules)
+			a.demo.Step(Input{Start: true})
+		}
+
+	case attractDemo:
+		a.demo.Step(Input{Dir: a.demo.AutopilotDir()})
+		snap := a.demo.Snapshot()
+		hud := append(g.hudLines(snap), "", "\033[1;35m   DEMO - Press SPACE to play!\033[0m")
+		g.show(g.renderScreen(snap), &snap, hud)
+
+		// The demo ends when Pac-Man's first life is over
+		lostLife := a.demo.lives < InitialLives && a.demo.State() != StateDying
+		if lostLife || a.ticks >= demoMaxTicks {
+			g.stopAttract()
+		}
+	}
+}
+
+// stopAttract returns attract mode to the static title.
+func (g *Game) stopAttract() {
+	g.attract.setPhase(attractTitle)
+	g.clearScreen()
+	if g.sim.State() == StateStart {
+		g.renderStartScreen()
+	}
+}
+
+// renderRoster draws the ghost introduction ticks into the roster: each
+// ghost appears, then its character name, then its nickname.
+func (g *Game) renderRoster(ticks int) {
+	screen := g.newScreen()
+	step := ticks / rosterStepTicks
+
+	g.renderer.RenderText(screen, BaseWidth/2, 3*TileSize, "CHARACTER / NICKNAME", ColorWhite)
+
+	for i, gtype := range []GhostType{GhostBlinky, GhostPinky, GhostInky, GhostClyde} {
+		ghost := NewGhost(gtype)
+		y := (6+3*i)*TileSize + TileSize/2
+		names := ghostNames[gtype]
+		shown := step - 2*i
+
+		if shown >= 0 {
+			g.renderer.RenderGhost(screen, 4*TileSize, y, ghost.GetColor(), false, false, false, ticks)
+		}
+		if shown >= 1 {
+			g.renderer.RenderText(screen, 11*TileSize, y, "-"+names[0], ghost.GetColor())
+		}
+		if shown >= 2 {
+			g.renderer.RenderText(screen, 20*TileSize, y, names[1], ghost.GetColor())
+		}
+	}
+
+	// Then what the pellets are worth
+	if step >= 9 {
+		g.renderer.fillRect(screen, 9*TileSize-1, 22*TileSize-1, 2, 2, ColorPellet)
+		g.renderer.RenderText(screen, 13*TileSize, 22*TileSize, "10 PTS", ColorWhite)
+		g.renderer.fillRect(screen, 9*TileSize-2, 24*TileSize-2, 4, 4, ColorPellet)
+		g.renderer.RenderText(screen, 13*TileSize, 24*TileSize, "50 PTS", ColorWhite)
+	}
+
+	g.show(screen, nil, []string{"", "\033[1;35m   Press SPACE to play!\033[0m"})
+}
+
+// newScreen returns a black frame the size of the game screen.
+func (g *Game) newScreen() *image.RGBA {
+	screen := image.NewRGBA(image.Rect(0, 0, g.width, g.height))
+
+	// Fill background with black (CRITICAL - test files do this!)
+	for y := 0; y < g.height; y++ {
+		for x := 0; x < g.width; x++ {
+			screen.Set(x, y, ColorBlack)
+		}
+	}
+	return screen
+}
@@ -0,0 +1,98 @@
+package game
+
+// autopilotDanger is how close (in tiles, Manhattan distance) the
+// autopilot lets a dangerous ghost get to a tile it walks through.
+const autopilotDanger = 3
+
+// AutopilotDir picks Pac-Man's direction for the attract-mode demo: the
+// first step of the shortest path to a pellet or frightened ghost that
+// keeps clear of the other ghosts, or straight away from them when no
+// such path exists.
+func (s *Sim) AutopilotDir() Direction {
+	maze := s.maze
+
+	type Node struct {
+		x, y     int
+		firstDir Direction // First direction taken from Pac-Man's tile
+	}
+
+	start := [2]int{s.pacman.X, s.pacman.Y}
+	queue := []Node{{start[0], start[1], DirNone}}
+	visited := map[[2]int]bool{start: true}
+
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+
+		if curr.firstDir != DirNone && s.autopilotGoal(curr.x, curr.y) {
+			return curr.firstDir
+		}
+
+		for _, dir := range []Direction{DirUp, DirDown, DirLeft, DirRight} {
+			m := Mover{X: curr.x, Y: curr.y}
+			nx, ny := m.nextTile(dir, maze)
+
+			key := [2]int{nx, ny}
+			if visited[key] || !maze.IsWalkable(nx, ny) || s.ghostDistance(nx, ny) <= autopilotDanger {
+				continue
+			}
+
+			visited[key] = true
+			firstDir := curr.firstDir
+			if firstDir == DirNone {
+				firstDir = dir
+			}
+			queue = append(queue, Node{nx, ny, firstDir})
+		}
+	}
+
+	// Nowhere safe to go - run from the closest ghost
+	bestDir, bestDist := s.pacman.Dir, -1
+	for _, dir := range []Direction{DirUp, DirDown, DirLeft, DirRight} {
+		nx, ny := s.pacman.nextTile(dir, maze)
+		if !maze.IsWalkable(nx, ny) {
+			continue
+		}
+		if dist := s.ghostDistance(nx, ny); dist > bestDist {
+			bestDir, bestDist = dir, dist
+		}
+	}
+	return bestDir
+}
+
+// autopilotGoal reports whether tile (x, y) is worth heading for: a pellet
+// or a frightened ghost.
+func (s *Sim) autopilotGoal(x, y int) bool {
+	switch s.maze.GetCell(x, y) {
+	case CellPellet, CellPowerPellet:
+		return true
+	}
+	for _, ghost := range s.ghosts {
+		if ghost.Mode == ModeFrightened && ghost.House == HouseOutside && ghost.X == x && ghost.Y == y {
+			return true
+		}
+	}
+	return false
+}
+
+// ghostDistance returns the Manhattan distance from tile (x, y) to the
+// nearest ghost that can catch Pac-Man.
+func (s *Sim) ghostDistance(x, y int) int {
+	best := s.maze.Width + s.maze.Height
+	for _, ghost := range s.ghosts {
+		if ghost.House != HouseOutside || ghost.Mode == ModeFrightened || ghost.Mode == ModeEaten {
+			continue
+		}
+		dx, dy := ghost.X-x, ghost.Y-y
+		if dx < 0 {
+			dx = -dx
+		}
+		if dy < 0 {
+			dy = -dy
+		}
+		if dx+dy < best {
+			best = dx + dy
+		}
+	}
+	return best
+}
@@ -0,0 +1,198 @@
+package game
+
+// ActorLook is what a cutscene actor is drawn as.
+type ActorLook int
+
+const (
+	LookHidden        ActorLook = iota
+	LookPacman                  // Regular Pac-Man
+	LookBigPacman               // Pac-Man at twice the size
+	LookBlinky                  // Blinky as he looks in the maze
+	LookFrightened              // Blue, frightened ghost
+	LookBlinkyTorn              // Blinky with his sheet torn
+	LookBlinkyPatched           // Blinky with his sheet sewn up
+	LookBlinkyNaked             // Blinky dragging his sheet behind him
+	LookNail                    // The nail Blinky's sheet snags on
+)
+
+// CutsceneMove is one leg of an actor's script: for Ticks ticks the actor
+// looks like Look and moves in Dir at Speed (percent of full speed).
+type CutsceneMove struct {
+	Ticks int
+	Look  ActorLook
+	Dir   Direction
+	Speed int
+	Place *Position // If set, the actor jumps to this pixel when the leg starts
+}
+
+// CutsceneScript is one actor's moves, played in order.
+type CutsceneScript []CutsceneMove
+
+// Row the intermissions play on (center pixel) and the screen's edges
+const (
+	cutsceneY     = 17*TileSize + TileSize/2
+	cutsceneRight = BaseWidth + 2*TileSize
+	cutsceneLeft  = -2 * TileSize
+)
+
+// intermissions holds the scripts of the three arcade intermissions, one
+// script per actor.
+var intermissions = [][]CutsceneScript{
+	// 1: Blinky chases Pac-Man off the left, then a big Pac-Man chases a
+	// frightened Blinky back across.
+	{
+		{
+			{Ticks: 150, Look: LookPacman, Dir: DirLeft, Speed: 80, Place: &Position{cutsceneRight, cutsceneY}},
+			{Ticks: 30},
+			{Ticks: 280, Look: LookBigPacman, Dir: DirRight, Speed: 50, Place: &Position{cutsceneLeft - 80, cutsceneY - TileSize/2}},
+		},
+		{
+			{Ticks: 150, Look: LookBlinky, Dir: DirLeft, Speed: 85, Place: &Position{cutsceneRight + 40, cutsceneY}},
+			{Ticks: 30},
+			{Ticks: 280, Look: LookFrightened, Dir: DirRight, Speed: 55, Place: &Position{cutsceneLeft, cutsceneY}},
+		},
+	},
+	// 2: Blinky's sheet snags on a nail as he chases Pac-Man and tears.
+	{
+		{
+			{Ticks: 150, Look: LookPacman, Dir: DirLeft, Speed: 80, Place: &Position{cutsceneRight, cutsceneY}},
+		},
+		{
+			// Runs until the back of his sheet reaches the nail
+			{Ticks: 84, Look: LookBlinky, Dir: DirLeft, Speed: 85, Place: &Position{cutsceneRight + 60, cutsceneY}},
+			{Ticks: 40, Look: LookBlinky, Dir: DirLeft, Speed: 10},
+			{Ticks: 60, Look: LookBlinkyTorn},
+			{Ticks: 60, Look: LookBlinkyTorn, Dir: DirRight},
+		},
+		{
+			{Ticks: 240, Look: LookNail, Place: &Position{BaseWidth/2 + 12, cutsceneY + TileSize/2}},
+		},
+	},
+	// 3: A patched-up Blinky chases Pac-Man, then comes back dragging his
+	// sheet behind him.
+	{
+		{
+			{Ticks: 150, Look: LookPacman, Dir: DirLeft, Speed: 80, Place: &Position{cutsceneRight, cutsceneY}},
+		},
+		{
+			{Ticks: 150, Look: LookBlinkyPatched, Dir: DirLeft, Speed: 85, Place: &Position{cutsceneRight + 40, cutsceneY}},
+			{Ticks: 40},
+			{Ticks: 150, Look: LookBlinkyNaked, Dir: DirRight, Speed: 85, Place: &Position{cutsceneLeft, cutsceneY}},
+		},
+	},
+}
+
+// intermissionAfter returns the intermission played after clearing level,
+// or 0 for none. Like the arcade, the third one repeats after levels 13
+// and 17.
+func intermissionAfter(level int) int {
+	switch level {
+	case 2:
+		return 1
+	case 5:
+		return 2
+	case 9, 13, 17:
+		return 3
+	}
+	return 0
+}
+
+// CutsceneActor is one actor's progress through its script.
+type CutsceneActor struct {
+	Mover
+	Look      ActorLook
+	Move      int // Current leg of the script
+	MoveTicks int // Ticks spent in the current leg
+	AnimFrame int
+}
+
+// Cutscene is a running intermission. It is plain data, driven one tick at
+// a time by Step like the rest of the Sim.
+type Cutscene struct {
+	Number int // Which intermission (1-3)
+	Actors []CutsceneActor
+	Done   bool // Every actor has finished its script
+}
+
+// NewCutscene starts intermission number (1-3).
+func NewCutscene(number int) *Cutscene {
+	return &Cutscene{
+		Number: number,
+		Actors: make([]CutsceneActor, len(intermissions[number-1])),
+	}
+}
+
+// Step advances every actor by one tick.
+func (c *Cutscene) Step() {
+	scripts := intermissions[c.Number-1]
+	c.Done = true
+	for i := range c.Actors {
+		actor, script := &c.Actors[i], scripts[i]
+		if actor.Move >= len(script) {
+			actor.Look = LookHidden
+			continue
+		}
+		c.Done = false
+
+		move := script[actor.Move]
+		if actor.MoveTicks == 0 {
+			if move.Place != nil {
+				actor.setPixel(move.Place.X, move.Place.Y)
+			}
+			actor.Look = move.Look
+			actor.Dir = move.Dir
+		}
+
+		for steps := actor.pixelsThisTick(move.Speed); steps > 0; steps-- {
+			px, py := actor.PX, actor.PY
+			switch move.Dir {
+			case DirUp:
+				py--
+			case DirDown:
+				py++
+			case DirLeft:
+				px--
+			case DirRight:
+				px++
+			}
+			actor.setPixel(px, py)
+		}
+		actor.AnimFrame++
+
+		actor.MoveTicks++
+		if actor.MoveTicks >= move.Ticks {
+			actor.Move++
+			actor.MoveTicks = 0
+		}
+	}
+}
+
+// Clone returns a deep copy of the cutscene.
+func (c *Cutscene) Clone() *Cutscene {
+	clone := *c
+	clone.Actors = append([]CutsceneActor(nil), c.Actors...)
+	return &clone
+}
+
+// startIntermission plays intermission number before the next level.
+func (s *Sim) startIntermission(number int) {
+	s.cutscene = NewCutscene(number)
+	s.setState(StateIntermission)
+}
+
+// updateIntermission advances the cutscene and moves on to the next level
+// once it is over.
+func (s *Sim) updateIntermission() {
+	s.stateTicks++
+	s.cutscene.Step()
+	if s.cutscene.Done {
+		s.endIntermission()
+	}
+}
+
+// endIntermission drops the cutscene, finished or skipped, and loads the
+// next level.
+func (s *Sim) endIntermission() {
+	s.cutscene = nil
+	s.NextLevel()
+}
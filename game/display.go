@@ -0,0 +1,183 @@
+package game
+
+import (
+	"fmt"
+	"image"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// Frame is one picture for a Display: the rendered game screen plus the
+// state behind it, for backends that draw more than the pixels.
+type Frame struct {
+	Screen *image.RGBA // Rendered at the display's scale; nil for text-only screens such as the title, and for the ASCII display
+	Snap   *Snapshot   // Game state shown; nil for screens outside a game, such as the roster
+	HUD    []string    // Status lines for below the picture, possibly with ANSI colours
+}
+
+// Display puts frames on the terminal. The Game renders every frame the
+// same way and leaves the output format to the Display, so backends can
+// be swapped with --display.
+type Display interface {
+	// Scale is the size, in screen pixels, frames are rendered at per
+	// game pixel.
+	Scale() int
+	// Show replaces whatever the previous frame left on the terminal.
+	Show(frame *Frame) error
+	// Clear blanks the terminal, including anything the display drew.
+	Clear() error
+	// Close removes anything the display left behind on the terminal.
+	Close() error
+	// ASCIIOnly reports whether the display shows nothing but 7-bit ASCII,
+	// so text for it should avoid emoji and box drawing.
+	ASCIIOnly() bool
+}
+
+// displays maps each --display name to its constructor.
+var displays = map[string]func(w io.Writer, caps TermCaps) Display{
+	"ascii":     NewASCIIDisplay,
+	"braille":   NewBrailleDisplay,
+	"halfblock": NewHalfBlockDisplay,
+	"kitty":     NewKittyDisplay,
+	"sixel":     NewSixelDisplay,
+}
+
+// AutoDisplay is the display name that picks the best backend for the
+// terminal's capabilities.
+const AutoDisplay = "auto"
+
+// displaysUsingCaps are the display names that need the terminal's
+// answers to DetectTermCaps. The others never query it, so nothing odd is
+// written to terminals that may not understand the queries.
+var displaysUsingCaps = map[string]bool{
+	AutoDisplay: true,
+	"kitty":     true,
+	"sixel":     true,
+}
+
+// DisplayNames returns the names accepted by NewDisplay: AutoDisplay, then
+// the backends sorted.
+func DisplayNames() []string {
+	names := make([]string, 0, len(displays))
+	for name := range displays {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return append([]string{AutoDisplay}, names...)
+}
+
+// NewDisplay returns the display backend called name, writing to w to a
+// terminal with caps.
+func NewDisplay(name string, w io.Writer, caps TermCaps) (Display, error) {
+	if name == AutoDisplay {
+		name = caps.BestDisplay()
+	}
+	newDisplay, ok := displays[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown display %q (want one of %s)", name, strings.Join(DisplayNames(), ", "))
+	}
+	return newDisplay(w, caps), nil
+}
+
+// clearScreen erases the whole terminal.
+func clearScreen(w io.Writer) error {
+	_, err := io.WriteString(w, "\033[2J")
+	return err
+}
+
+// showText puts a text-only frame on the terminal: its HUD lines, from
+// the top of the screen.
+func showText(w io.Writer, lines []string) error {
+	if _, err := io.WriteString(w, "\033[0m\033[H"); err != nil {
+		return err
+	}
+	return writeHUD(w, lines)
+}
+
+// hudRows is the most lines of HUD shown below the game screen
+const hudRows = 8
+
+// calculateScale picks the largest scale at which the game screen and the
+// HUD fit the terminal, using the cell size the terminal reported or
+// assuming 8x16 pixel cells.
+func calculateScale(caps TermCaps) int {
+	cols, rows := caps.Cols, caps.Rows
+	if cols == 0 || rows == 0 {
+		var err error
+		if cols, rows, err = term.GetSize(int(os.Stdout.Fd())); err != nil {
+			return 2
+		}
+	}
+	cellWidth, cellHeight := caps.CellWidth, caps.CellHeight
+	if cellWidth == 0 || cellHeight == 0 {
+		cellWidth, cellHeight = 8, 16
+	}
+
+	pixelWidth := cols * cellWidth
+	pixelHeight := (rows - hudRows) * cellHeight
+	scaleWidth := pixelWidth / BaseWidth
+	scaleHeight := pixelHeight / BaseHeight
+	scale := scaleWidth
+	if scaleHeight < scaleWidth {
+		scale = scaleHeight
+	}
+	if scale < 1 {
+		scale = 1
+	}
+	if scale > 5 {
+		scale = 5
+	}
+	return scale
+}
+
+// textScale is the scale text displays have frames rendered at: the
+// smallest one sprites are drawn at full detail, before logicalFrame
+// shrinks them back down.
+const textScale = 2
+
+// logicalFrame shrinks a rendered screen to the game's own BaseWidth x
+// BaseHeight pixels. Each pixel takes the first non-black colour of the
+// block it replaces, so thin details such as the ghost door survive.
+func logicalFrame(screen *image.RGBA) *image.RGBA {
+	bounds := screen.Bounds()
+	factor := bounds.Dx() / BaseWidth
+	if factor <= 1 {
+		return screen
+	}
+
+	frame := image.NewRGBA(image.Rect(0, 0, BaseWidth, BaseHeight))
+	for y := 0; y < BaseHeight; y++ {
+		for x := 0; x < BaseWidth; x++ {
+			c := ColorBlack
+		block:
+			for dy := 0; dy < factor; dy++ {
+				for dx := 0; dx < factor; dx++ {
+					p := screen.RGBAAt(bounds.Min.X+x*factor+dx, bounds.Min.Y+y*factor+dy)
+					if p.R|p.G|p.B != 0 {
+						c = p
+						break block
+					}
+				}
+			}
+			frame.SetRGBA(x, y, c)
+		}
+	}
+	return frame
+}
+
+// writeHUD prints the HUD lines from the cursor on, clearing what the
+// previous frame left on each line and below the last one.
+func writeHUD(w io.Writer, lines []string) error {
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(line)
+		b.WriteString("\033[K\n")
+	}
+	b.WriteString("\033[J")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,151 @@
+package game
+
+import (
+	"bytes"
+	"io"
+	"strings"
+)
+
+// Characters drawn by the ASCII display
+const (
+	asciiWall        = '#'
+	asciiPellet      = '.'
+	asciiPowerPellet = 'o'
+	asciiDoor        = '-'
+	asciiPacman      = 'C'
+	asciiGhost       = 'M'
+	asciiEmpty       = ' '
+)
+
+// ASCIIDisplay draws the game one character per maze tile in plain 7-bit
+// ASCII, from the frame's Snapshot rather than its pixels. Every frame is
+// the whole maze as plain lines, with no escapes beyond moving the cursor
+// home and clearing stale HUD text, so it works in the dumbest terminals
+// and its output reads well in logs and CI snapshots.
+type ASCIIDisplay struct {
+	w io.Writer
+}
+
+// NewASCIIDisplay returns an ASCII display writing to w.
+func NewASCIIDisplay(w io.Writer, caps TermCaps) Display {
+	return &ASCIIDisplay{w: w}
+}
+
+// Scale is 1: only the snapshot is drawn, so frames need no pixels.
+func (d *ASCIIDisplay) Scale() int {
+	return 1
+}
+
+// Show draws the maze from frame.Snap, ignoring frame.Screen, so headless
+// callers can pass a Frame with only a Snapshot. Screens outside a game
+// show just their text.
+func (d *ASCIIDisplay) Show(frame *Frame) error {
+	var out bytes.Buffer
+	out.WriteString("\033[H") // Move cursor to home
+	if frame.Snap != nil {
+		for _, row := range asciiGrid(frame.Snap) {
+			out.Write(row)
+			out.WriteByte('\n')
+		}
+	}
+
+	hud := make([]string, len(frame.HUD))
+	for i, line := range frame.HUD {
+		hud[i] = asciiText(line)
+	}
+	if err := writeHUD(&out, hud); err != nil {
+		return err
+	}
+	_, err := d.w.Write(out.Bytes())
+	return err
+}
+
+func (d *ASCIIDisplay) Clear() error {
+	return clearScreen(d.w)
+}
+
+func (d *ASCIIDisplay) ASCIIOnly() bool {
+	return true
+}
+
+// Close does nothing; the text goes with the alternate screen.
+func (d *ASCIIDisplay) Close() error {
+	return nil
+}
+
+// asciiGrid returns the rows of characters showing snap.
+func asciiGrid(snap *Snapshot) [][]byte {
+	grid := make([][]byte, BaseHeight/TileSize)
+	for y := range grid {
+		grid[y] = []byte(strings.Repeat(string(asciiEmpty), BaseWidth/TileSize))
+	}
+	put := func(x, y int, c byte) {
+		if y >= 0 && y < len(grid) && x >= 0 && x < len(grid[y]) {
+			grid[y][x] = c
+		}
+	}
+
+	// Intermissions play on an empty screen
+	if snap.State == StateIntermission {
+		for _, actor := range snap.Cutscene.Actors {
+			switch actor.Look {
+			case LookPacman, LookBigPacman:
+				put(actor.PX/TileSize, actor.PY/TileSize, asciiPacman)
+			case LookHidden, LookNail:
+			default:
+				put(actor.PX/TileSize, actor.PY/TileSize, asciiGhost)
+			}
+		}
+		return grid
+	}
+
+	for y := 0; y < snap.Maze.Height; y++ {
+		for x := 0; x < snap.Maze.Width; x++ {
+			switch snap.Maze.GetCell(x, y) {
+			case CellWall:
+				put(x, y, asciiWall)
+			case CellPellet:
+				put(x, y, asciiPellet)
+			case CellPowerPellet:
+				put(x, y, asciiPowerPellet)
+			case CellGhostDoor:
+				put(x, y, asciiDoor)
+			}
+		}
+	}
+
+	// Game over clears the maze of actors, and the ghosts go while Pac-Man
+	// dies
+	if snap.State != StateGameOver {
+		put(snap.Pacman.X, snap.Pacman.Y, asciiPacman)
+	}
+	if snap.State != StateGameOver && snap.State != StateDying {
+		for _, ghost := range snap.Ghosts {
+			put(ghost.X, ghost.Y, asciiGhost)
+		}
+	}
+	return grid
+}
+
+// asciiText strips the colour escapes and any characters outside 7-bit
+// ASCII from a HUD line, keeping box rules as '='.
+func asciiText(line string) string {
+	var b strings.Builder
+	inEscape := false
+	for _, r := range line {
+		switch {
+		case inEscape:
+			// CSI sequences end with a letter
+			if r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' {
+				inEscape = false
+			}
+		case r == '\033':
+			inEscape = true
+		case r == '═':
+			b.WriteByte('=')
+		case r >= ' ' && r < 0x7f:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
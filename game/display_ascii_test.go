@@ -0,0 +1,68 @@
+package game
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestASCIIDisplayIs7Bit checks the title and a game frame come out as
+// plain 7-bit ASCII, with the recent fruit spelled out.
+func TestASCIIDisplayIs7Bit(t *testing.T) {
+	var buf bytes.Buffer
+	display := NewASCIIDisplay(&buf, TermCaps{})
+	g := &Game{
+		sim:      NewSim(1, Rules{ExtraLife: ExtraLife{Score: 10000}}),
+		renderer: NewRenderer(display.Scale()),
+		display:  display,
+		scale:    display.Scale(),
+		width:    BaseWidth * display.Scale(),
+		height:   BaseHeight * display.Scale(),
+	}
+
+	g.renderStartScreen()
+	title := buf.String()
+
+	buf.Reset()
+	g.sim.Step(Input{Start: true})
+	g.sim.level = 3
+	g.render(g.sim.Snapshot())
+	frame := buf.String()
+
+	for name, out := range map[string]string{"title": title, "frame": frame} {
+		for i := 0; i < len(out); i++ {
+			if out[i] >= 0x80 {
+				t.Fatalf("%s has non-ASCII byte %#x at %d: %q", name, out[i], i, out[max(0, i-20):i+1])
+			}
+		}
+	}
+	if !strings.Contains(title, "Press SPACE to Start!") {
+		t.Error("title is missing its prompt")
+	}
+	if !strings.Contains(frame, " FRUIT: CHERRY STRAWBERRY ORANGE ") {
+		t.Errorf("frame is missing the fruit row:\n%s", frame)
+	}
+}
+
+// TestASCIIDisplayDrawsSnapshot checks a frame with only a Snapshot, as a
+// headless caller would send, still gets its maze drawn.
+func TestASCIIDisplayDrawsSnapshot(t *testing.T) {
+	sim := NewSim(1, Rules{})
+	sim.Step(Input{Start: true})
+	snap := sim.Snapshot()
+
+	var buf bytes.Buffer
+	if err := NewASCIIDisplay(&buf, TermCaps{}).Show(&Frame{Snap: &snap}); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(buf.String(), "\n")
+	if len(lines) < snap.Maze.Height {
+		t.Fatalf("got %d lines, want the %d maze rows", len(lines), snap.Maze.Height)
+	}
+	if want := string(asciiGrid(&snap)[0]); !strings.Contains(lines[0], want) {
+		t.Errorf("first row is %q, want the maze's top row %q", lines[0], want)
+	}
+	if !strings.ContainsRune(buf.String(), asciiPacman) {
+		t.Error("Pac-Man is not drawn")
+	}
+}
@@ -0,0 +1,100 @@
+package game
+
+import (
+	"bufio"
+	"io"
+)
+
+// brailleDots maps a pixel's position in a 2x4 block to its dot in a
+// Braille character (U+2800 plus the dot bits).
+var brailleDots = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+// BrailleDisplay draws frames in monochrome Braille characters, each
+// packing a 2x4 block of the game's pixels, for the most detail a plain
+// text terminal can show. Any pixel that isn't black is a raised dot.
+// Like HalfBlockDisplay, it only sends cells that changed.
+type BrailleDisplay struct {
+	w     io.Writer
+	cells []rune // What is on the terminal, row by row; nil when unknown
+}
+
+// NewBrailleDisplay returns a Braille display writing to w.
+func NewBrailleDisplay(w io.Writer, caps TermCaps) Display {
+	return &BrailleDisplay{w: w}
+}
+
+func (d *BrailleDisplay) Scale() int {
+	return textScale
+}
+
+func (d *BrailleDisplay) Show(frame *Frame) error {
+	if frame.Screen == nil {
+		d.cells = nil // The text covers the cells
+		return showText(d.w, frame.HUD)
+	}
+
+	screen := logicalFrame(frame.Screen)
+	bounds := screen.Bounds()
+	cols, rows := (bounds.Dx()+1)/2, (bounds.Dy()+3)/4
+	if len(d.cells) != cols*rows {
+		d.cells = nil // First frame, or the size changed: paint every cell
+	}
+
+	bw := bufio.NewWriter(d.w)
+	out := newCellWriter(bw)
+	out.reset()
+	cells := make([]rune, cols*rows)
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			cell := rune(0x2800)
+			for dy, dots := range brailleDots {
+				for dx, dot := range dots {
+					x, y := bounds.Min.X+2*col+dx, bounds.Min.Y+4*row+dy
+					if x >= bounds.Max.X || y >= bounds.Max.Y {
+						continue
+					}
+					if p := screen.RGBAAt(x, y); p.R|p.G|p.B != 0 {
+						cell |= dot
+					}
+				}
+			}
+
+			i := row*cols + col
+			cells[i] = cell
+			if d.cells != nil && d.cells[i] == cell {
+				continue
+			}
+			out.moveTo(row, col)
+			out.put(string(cell))
+		}
+	}
+	d.cells = cells
+
+	out.moveTo(rows, 0)
+	if out.err != nil {
+		return out.err
+	}
+	if err := writeHUD(bw, frame.HUD); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func (d *BrailleDisplay) Clear() error {
+	d.cells = nil
+	return clearScreen(d.w)
+}
+
+func (d *BrailleDisplay) ASCIIOnly() bool {
+	return false
+}
+
+// Close does nothing; the text goes with the alternate screen.
+func (d *BrailleDisplay) Close() error {
+	return nil
+}
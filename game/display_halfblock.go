@@ -0,0 +1,159 @@
+package game
+
+import (
+	"bufio"
+	"fmt"
+	"image/color"
+	"io"
+)
+
+// HalfBlockDisplay draws frames as text for terminals without an image
+// protocol: each character cell shows two of the game's pixels stacked
+// vertically, as a ▀ in the top pixel's colour over the bottom pixel's
+// background, in 24-bit colour. Only cells that changed since the last
+// frame are sent, which keeps it usable over a slow SSH link.
+type HalfBlockDisplay struct {
+	w     io.Writer
+	cells []halfBlockCell // What is on the terminal, row by row; nil when unknown
+}
+
+// halfBlockCell is the pair of pixels shown by one character cell.
+type halfBlockCell struct {
+	top, bottom color.RGBA
+}
+
+// NewHalfBlockDisplay returns a half-block display writing to w.
+func NewHalfBlockDisplay(w io.Writer, caps TermCaps) Display {
+	return &HalfBlockDisplay{w: w}
+}
+
+func (d *HalfBlockDisplay) Scale() int {
+	return textScale
+}
+
+func (d *HalfBlockDisplay) Show(frame *Frame) error {
+	if frame.Screen == nil {
+		d.cells = nil // The text covers the cells
+		return showText(d.w, frame.HUD)
+	}
+
+	screen := logicalFrame(frame.Screen)
+	bounds := screen.Bounds()
+	cols, rows := bounds.Dx(), (bounds.Dy()+1)/2
+	if len(d.cells) != cols*rows {
+		d.cells = nil // First frame, or the size changed: paint every cell
+	}
+
+	bw := bufio.NewWriter(d.w)
+	out := newCellWriter(bw)
+	cells := make([]halfBlockCell, cols*rows)
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			cell := halfBlockCell{
+				top:    screen.RGBAAt(bounds.Min.X+col, bounds.Min.Y+2*row),
+				bottom: ColorBlack,
+			}
+			if 2*row+1 < bounds.Dy() {
+				cell.bottom = screen.RGBAAt(bounds.Min.X+col, bounds.Min.Y+2*row+1)
+			}
+			i := row*cols + col
+			cells[i] = cell
+			if d.cells != nil && d.cells[i] == cell {
+				continue
+			}
+
+			out.moveTo(row, col)
+			if cell.top == cell.bottom {
+				// A solid cell only needs its background
+				out.setBackground(cell.bottom)
+				out.put(" ")
+			} else {
+				out.setForeground(cell.top)
+				out.setBackground(cell.bottom)
+				out.put("▀")
+			}
+		}
+	}
+	d.cells = cells
+
+	out.reset()
+	out.moveTo(rows, 0)
+	if out.err != nil {
+		return out.err
+	}
+	if err := writeHUD(bw, frame.HUD); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func (d *HalfBlockDisplay) Clear() error {
+	d.cells = nil
+	return clearScreen(d.w)
+}
+
+func (d *HalfBlockDisplay) ASCIIOnly() bool {
+	return false
+}
+
+// Close does nothing; the text goes with the alternate screen.
+func (d *HalfBlockDisplay) Close() error {
+	return nil
+}
+
+// cellWriter writes characters at given cells, leaving out cursor moves
+// and colour changes the terminal doesn't need.
+type cellWriter struct {
+	w        *bufio.Writer
+	row, col int // Where the cursor is; row -1 when unknown
+	fg, bg   *color.RGBA
+	err      error // First write that failed; nothing more is written after it
+}
+
+func newCellWriter(w *bufio.Writer) *cellWriter {
+	return &cellWriter{w: w, row: -1}
+}
+
+// moveTo puts the cursor on the cell at row, col (from 0).
+func (c *cellWriter) moveTo(row, col int) {
+	if row == c.row && col == c.col {
+		return
+	}
+	c.write(fmt.Sprintf("\033[%d;%dH", row+1, col+1))
+	c.row, c.col = row, col
+}
+
+// put writes a one-column character at the cursor.
+func (c *cellWriter) put(s string) {
+	c.write(s)
+	c.col++
+}
+
+func (c *cellWriter) setForeground(fg color.RGBA) {
+	if c.fg != nil && *c.fg == fg {
+		return
+	}
+	c.write(fmt.Sprintf("\033[38;2;%d;%d;%dm", fg.R, fg.G, fg.B))
+	c.fg = &fg
+}
+
+func (c *cellWriter) setBackground(bg color.RGBA) {
+	if c.bg != nil && *c.bg == bg {
+		return
+	}
+	c.write(fmt.Sprintf("\033[48;2;%d;%d;%dm", bg.R, bg.G, bg.B))
+	c.bg = &bg
+}
+
+// reset returns the terminal to its default colours.
+func (c *cellWriter) reset() {
+	c.write("\033[0m")
+	c.fg, c.bg = nil, nil
+}
+
+// write sends s unless an earlier write failed.
+func (c *cellWriter) write(s string) {
+	if c.err == nil {
+		_, c.err = c.w.WriteString(s)
+	}
+}
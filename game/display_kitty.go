@@ -0,0 +1,123 @@
+package game
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"io"
+)
+
+// Kitty graphics protocol settings
+const (
+	kittyImageID     = 1    // Every frame replaces the image with this ID...
+	kittyPlacementID = 1    // ...and the placement, so it is updated in place
+	kittyChunkSize   = 4096 // Largest base64 payload the protocol allows per escape
+	kittyFormatRGBA  = 32   // f=32: raw 8-bit RGBA pixels
+)
+
+// KittyDisplay sends frames with the kitty graphics protocol, which
+// WezTerm and others also speak. Frames go out as zlib-compressed RGBA
+// with no palette quantization, and each one replaces the last in place.
+type KittyDisplay struct {
+	w     io.Writer
+	scale int
+}
+
+// NewKittyDisplay returns a kitty graphics display writing to w, scaled to
+// fit the terminal.
+func NewKittyDisplay(w io.Writer, caps TermCaps) Display {
+	return &KittyDisplay{w: w, scale: calculateScale(caps)}
+}
+
+func (d *KittyDisplay) Scale() int {
+	return d.scale
+}
+
+func (d *KittyDisplay) Show(frame *Frame) error {
+	if frame.Screen == nil {
+		return showText(d.w, frame.HUD)
+	}
+
+	var pixels bytes.Buffer
+	zw := zlib.NewWriter(&pixels)
+	if _, err := zw.Write(rgbaPixels(frame.Screen)); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	payload := base64.StdEncoding.EncodeToString(pixels.Bytes())
+
+	// Frames are large, so build the escapes in memory and send them in
+	// one write
+	var out bytes.Buffer
+	out.WriteString("\033[H") // Move cursor to home
+
+	// q=2 keeps the terminal from answering, since replies would arrive as
+	// key presses
+	bounds := frame.Screen.Bounds()
+	control := fmt.Sprintf("a=T,f=%d,o=z,s=%d,v=%d,i=%d,p=%d,q=2",
+		kittyFormatRGBA, bounds.Dx(), bounds.Dy(), kittyImageID, kittyPlacementID)
+	for len(payload) > 0 {
+		chunk := payload
+		if len(chunk) > kittyChunkSize {
+			chunk = chunk[:kittyChunkSize]
+		}
+		payload = payload[len(chunk):]
+
+		more := 0
+		if len(payload) > 0 {
+			more = 1
+		}
+		if control != "" {
+			fmt.Fprintf(&out, "\033_G%s,m=%d;%s\033\\", control, more, chunk)
+			control = "" // Only the first chunk carries the keys
+		} else {
+			fmt.Fprintf(&out, "\033_Gm=%d;%s\033\\", more, chunk)
+		}
+	}
+
+	// The cursor is left on the image's last row; start the HUD below it
+	out.WriteString("\r\n")
+	if err := writeHUD(&out, frame.HUD); err != nil {
+		return err
+	}
+	_, err := d.w.Write(out.Bytes())
+	return err
+}
+
+// Clear removes the image from the screen, keeping its data for the next
+// frame, and erases the text.
+func (d *KittyDisplay) Clear() error {
+	if _, err := fmt.Fprintf(d.w, "\033_Ga=d,d=i,i=%d,q=2\033\\", kittyImageID); err != nil {
+		return err
+	}
+	return clearScreen(d.w)
+}
+
+func (d *KittyDisplay) ASCIIOnly() bool {
+	return false
+}
+
+// Close deletes the image and frees its data in the terminal.
+func (d *KittyDisplay) Close() error {
+	_, err := fmt.Fprintf(d.w, "\033_Ga=d,d=I,i=%d,q=2\033\\", kittyImageID)
+	return err
+}
+
+// rgbaPixels returns img's pixels as tightly packed RGBA rows.
+func rgbaPixels(img *image.RGBA) []byte {
+	bounds := img.Bounds()
+	rowLen := 4 * bounds.Dx()
+	if img.Stride == rowLen {
+		return img.Pix[:rowLen*bounds.Dy()]
+	}
+	pixels := make([]byte, 0, rowLen*bounds.Dy())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		start := img.PixOffset(bounds.Min.X, y)
+		pixels = append(pixels, img.Pix[start:start+rowLen]...)
+	}
+	return pixels
+}
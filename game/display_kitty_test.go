@@ -0,0 +1,121 @@
+package game
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"flag"
+	"image"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// kittyTestFrame returns a small frame of noise, which compresses badly
+// enough to need several chunks.
+func kittyTestFrame() *Frame {
+	screen := image.NewRGBA(image.Rect(0, 0, 48, 40))
+	rng := rand.New(rand.NewSource(1))
+	rng.Read(screen.Pix)
+	return &Frame{Screen: screen, HUD: []string{" SCORE: 10"}}
+}
+
+// checkGolden compares got with testdata/name, or rewrites the file when
+// the tests run with -update.
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output differs from %s (rerun with -update if the change is intended)\ngot:  %q\nwant: %q", path, got, want)
+	}
+}
+
+// kittyPayload matches the base64 payload of a graphics escape.
+var kittyPayload = regexp.MustCompile(`;([A-Za-z0-9+/=]*)\033\\`)
+
+// TestKittyDisplayShow checks a frame's escapes against the golden file with
+// the payloads left out, since compress/zlib doesn't promise stable output,
+// and checks the payloads decode to the frame's pixels.
+func TestKittyDisplayShow(t *testing.T) {
+	var buf bytes.Buffer
+	d := &KittyDisplay{w: &buf, scale: 1}
+	frame := kittyTestFrame()
+
+	if err := d.Show(frame); err != nil {
+		t.Fatal(err)
+	}
+	first := buf.String()
+
+	var payload strings.Builder
+	chunks := kittyPayload.FindAllStringSubmatch(first, -1)
+	if len(chunks) < 3 {
+		t.Fatalf("got %d chunks, want the frame split over at least 3", len(chunks))
+	}
+	for i, chunk := range chunks {
+		if i < len(chunks)-1 && len(chunk[1]) != kittyChunkSize {
+			t.Errorf("chunk %d has %d bytes of payload, want %d", i, len(chunk[1]), kittyChunkSize)
+		}
+		payload.WriteString(chunk[1])
+	}
+	framing := kittyPayload.ReplaceAllString(first, ";<payload>\033\\")
+	checkGolden(t, "kitty_show.golden", []byte(framing))
+
+	compressed, err := base64.StdEncoding.DecodeString(payload.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pixels, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pixels, frame.Screen.Pix) {
+		t.Errorf("payload decodes to %d bytes that differ from the frame's %d pixel bytes", len(pixels), len(frame.Screen.Pix))
+	}
+
+	// The next frame is sent the same way, replacing the image in place
+	buf.Reset()
+	if err := d.Show(frame); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != first {
+		t.Error("second frame differs from the first; want the same image and placement IDs")
+	}
+}
+
+func TestKittyDisplayClearClose(t *testing.T) {
+	var buf bytes.Buffer
+	d := &KittyDisplay{w: &buf, scale: 1}
+
+	if err := d.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "kitty_clear.golden", buf.Bytes())
+
+	buf.Reset()
+	if err := d.Close(); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "kitty_close.golden", buf.Bytes())
+}
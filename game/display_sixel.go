@@ -0,0 +1,60 @@
+package game
+
+import (
+	"io"
+
+	"github.com/mattn/go-sixel"
+)
+
+// SixelDisplay draws frames as Sixel images with the HUD printed as text
+// underneath.
+type SixelDisplay struct {
+	w      io.Writer
+	scale  int
+	colors int // Palette size, within the terminal's colour registers; 0 for the encoder's default
+}
+
+// NewSixelDisplay returns a Sixel display writing to w, scaled to fit the
+// terminal.
+func NewSixelDisplay(w io.Writer, caps TermCaps) Display {
+	d := &SixelDisplay{w: w, scale: calculateScale(caps)}
+	if caps.ColorRegisters > 1 && caps.ColorRegisters < 256 {
+		d.colors = caps.ColorRegisters
+	}
+	return d
+}
+
+func (d *SixelDisplay) Scale() int {
+	return d.scale
+}
+
+func (d *SixelDisplay) Show(frame *Frame) error {
+	if frame.Screen == nil {
+		return showText(d.w, frame.HUD)
+	}
+	if _, err := io.WriteString(d.w, "\033[H"); err != nil { // Move cursor to home
+		return err
+	}
+	enc := sixel.NewEncoder(d.w)
+	enc.Colors = d.colors
+	if err := enc.Encode(frame.Screen); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(d.w, "\n"); err != nil {
+		return err
+	}
+	return writeHUD(d.w, frame.HUD)
+}
+
+func (d *SixelDisplay) Clear() error {
+	return clearScreen(d.w)
+}
+
+func (d *SixelDisplay) ASCIIOnly() bool {
+	return false
+}
+
+// Close does nothing; Sixel images go with the alternate screen.
+func (d *SixelDisplay) Close() error {
+	return nil
+}
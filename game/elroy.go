@@ -0,0 +1,59 @@
+package game
+
+// Pellets remaining when Blinky enters Cruise Elroy stage 1 on a level.
+// Stage 2 starts at half that number.
+func elroyDotsLeft(level int) int {
+	switch {
+	case level <= 1:
+		return 20
+	case level == 2:
+		return 30
+	case level <= 5:
+		return 40
+	case level <= 8:
+		return 50
+	case level <= 11:
+		return 60
+	case level <= 14:
+		return 80
+	case level <= 18:
+		return 100
+	default:
+		return 120
+	}
+}
+
+// updateElroy sets Blinky's Cruise Elroy stage from the pellets left.
+// After Pac-Man dies Elroy is suspended until Clyde has left the house.
+func (s *Sim) updateElroy() {
+	var blinky, clyde *Ghost
+	for _, ghost := range s.ghosts {
+		switch ghost.Type {
+		case GhostBlinky:
+			blinky = ghost
+		case GhostClyde:
+			clyde = ghost
+		}
+	}
+	if blinky == nil {
+		return
+	}
+
+	if s.elroySuspended {
+		if clyde != nil && clyde.House != HouseOutside {
+			blinky.Elroy = 0
+			return
+		}
+		s.elroySuspended = false
+	}
+
+	dotsLeft := elroyDotsLeft(s.level)
+	switch {
+	case s.maze.RemainingPellets <= dotsLeft/2:
+		blinky.Elroy = 2
+	case s.maze.RemainingPellets <= dotsLeft:
+		blinky.Elroy = 1
+	default:
+		blinky.Elroy = 0
+	}
+}
@@ -7,25 +7,22 @@ import (
 
 // Pacman entity
 type Pacman struct {
-	X, Y       int // Tile position
-	Dir        Direction
+	Mover
 	NextDir    Direction
 	AnimFrame  int
-	MoveTick   int
 	PowerMode  bool
 	PowerTicks int
+	Eating     bool // The current tile had a pellet, so Pac-Man is slowed
 }
 
 func NewPacman() *Pacman {
-	return &Pacman{
-		X:       14,
-		Y:       23,
-		Dir:     DirNone,
-		NextDir: DirNone,
-	}
+	p := &Pacman{NextDir: DirNone}
+	p.SetTile(14, 23)
+	return p
 }
 
-func (p *Pacman) Update(maze *Maze) {
+// Update advances Pac-Man by one tick at speed (percent of full speed).
+func (p *Pacman) Update(maze *Maze, speed int) {
 	// Update power mode timer
 	if p.PowerMode {
 		p.PowerTicks--
@@ -37,82 +34,123 @@ func (p *Pacman) Update(maze *Maze) {
 	// Update animation
 	p.AnimFrame++
 
-	// Movement with speed control - FASTER during power mode
-	speed := MovementSpeed
-	if p.PowerMode {
-		speed = MovementSpeed - 1 // Faster during power mode
-		if speed < 1 {
-			speed = 1
-		}
+	// Reversing is allowed anywhere, not just on a tile center
+	if p.NextDir != DirNone && p.NextDir == oppositeDir(p.Dir) {
+		p.Dir = p.NextDir
+		p.NextDir = DirNone
 	}
 
-	p.MoveTick++
-	if p.MoveTick < speed {
-		return // Don't move yet
-	}
-	p.MoveTick = 0
-
-	// Try buffered direction change
-	if p.NextDir != DirNone {
-		nx, ny := p.getNextPos(p.NextDir)
-		if maze.IsWalkable(nx, ny) {
+	for steps := p.pixelsThisTick(speed); steps > 0; steps-- {
+		// Take a buffered turn, cutting the corner if it's close enough
+		if p.NextDir != DirNone && p.canTurn(p.NextDir, maze) {
 			p.Dir = p.NextDir
 			p.NextDir = DirNone
 		}
-	}
-
-	// Move in current direction
-	if p.Dir != DirNone {
-		nx, ny := p.getNextPos(p.Dir)
 
-		// Tunnel wraparound
-		if nx < 0 {
-			nx = maze.Width - 1
-		} else if nx >= maze.Width {
-			nx = 0
+		if p.blocked(maze) {
+			p.SpeedCredit = 0
+			return
 		}
+		p.step(p.Dir, maze)
+		p.centerOnLane(maze)
+	}
+}
 
-		if maze.IsWalkable(nx, ny) {
-			p.X = nx
-			p.Y = ny
-		}
+// blocked reports whether Pac-Man has reached the middle of his tile along
+// his direction of travel with a wall ahead. Only that axis counts, since
+// he may still be sliding back onto the lane after cutting a corner.
+func (p *Pacman) blocked(maze *Maze) bool {
+	var pos int
+	switch p.Dir {
+	case DirUp, DirDown:
+		pos = p.PY
+	case DirLeft, DirRight:
+		pos = p.PX
+	default:
+		return true
+	}
+	if pos%TileSize != TileSize/2 {
+		return false
 	}
+	nx, ny := p.nextTile(p.Dir, maze)
+	return !maze.IsWalkable(nx, ny)
 }
 
-func (p *Pacman) getNextPos(dir Direction) (int, int) {
-	nx, ny := p.X, p.Y
+// cornerWindow is how many pixels before or after a tile's center Pac-Man
+// may already turn into an open side tile. Ghosts always turn on the center.
+const cornerWindow = 3
+
+// canTurn reports whether Pac-Man can start moving in dir now. Like the
+// arcade, turns may be taken a few pixels early (pre-turn) or late
+// (post-turn), which lets skilled players cut corners.
+func (p *Pacman) canTurn(dir Direction, maze *Maze) bool {
+	nx, ny := p.nextTile(dir, maze)
+	if !maze.IsWalkable(nx, ny) {
+		return false
+	}
+
+	// Distance from the tile center across the new direction of travel
+	var off int
 	switch dir {
-	case DirUp:
-		ny--
-	case DirDown:
-		ny++
-	case DirLeft:
-		nx--
-	case DirRight:
-		nx++
+	case DirUp, DirDown:
+		off = p.PX%TileSize - TileSize/2
+	case DirLeft, DirRight:
+		off = p.PY%TileSize - TileSize/2
+	}
+	return off >= -cornerWindow && off <= cornerWindow
+}
+
+// centerOnLane pulls Pac-Man one pixel back towards the middle of the lane
+// he is moving along. After a corner cut this makes him move diagonally
+// until he is lined up again.
+func (p *Pacman) centerOnLane(maze *Maze) {
+	px, py := p.PX, p.PY
+	center := TileSize / 2
+	switch p.Dir {
+	case DirUp, DirDown:
+		if off := px%TileSize - center; off < 0 {
+			px++
+		} else if off > 0 {
+			px--
+		}
+	case DirLeft, DirRight:
+		if off := py%TileSize - center; off < 0 {
+			py++
+		} else if off > 0 {
+			py--
+		}
+	}
+	if px != p.PX || py != p.PY {
+		p.setPixel(px, py)
 	}
-	return nx, ny
 }
 
 func (p *Pacman) SetDirection(dir Direction) {
 	p.NextDir = dir
 }
 
-func (p *Pacman) ActivatePowerMode(level int) {
-	p.PowerMode = true
-	// Power duration decreases with level (like original Pac-Man)
-	switch {
-	case level == 1:
-		p.PowerTicks = 48 // ~6 seconds at 8 FPS
-	case level == 2:
-		p.PowerTicks = 40 // ~5 seconds
-	case level <= 4:
-		p.PowerTicks = 32 // ~4 seconds
-	case level <= 8:
-		p.PowerTicks = 24 // ~3 seconds
-	default:
-		p.PowerTicks = 16 // ~2 seconds for high levels
+// frightSeconds is how long a power pellet frightens the ghosts on each
+// level, from the arcade. On level 17, and past the end of the table, the
+// ghosts only turn around.
+var frightSeconds = []int{6, 5, 4, 3, 2, 5, 2, 2, 1, 5, 2, 1, 1, 3, 1, 1, 0, 1}
+
+// frightBlinkTicks is how long before fright time runs out the ghosts
+// start flashing
+const frightBlinkTicks = 2 * TicksPerSecond
+
+// frightTicks returns the fright time for level, in ticks.
+func frightTicks(level int) int {
+	if level < 1 || level > len(frightSeconds) {
+		return 0
 	}
+	return frightSeconds[level-1] * TicksPerSecond
+}
+
+// ActivatePowerMode starts the level's fright time. Levels without one
+// leave power mode off.
+func (p *Pacman) ActivatePowerMode(level int) {
+	p.PowerTicks = frightTicks(level)
+	p.PowerMode = p.PowerTicks > 0
 }
 
 func (p *Pacman) PowerTimeLeft() int {
@@ -121,123 +159,163 @@ func (p *Pacman) PowerTimeLeft() int {
 
 // Ghost entity
 type Ghost struct {
+	Mover
 	Type         GhostType
-	X, Y         int // Tile position
-	Dir          Direction
 	Mode         GhostMode
-	MoveTick     int
 	AnimFrame    int
 	TargetX      int // Target position when eaten
 	TargetY      int
-	RespawnTimer int // Immunity after respawning
+	RespawnTimer int        // Immunity after respawning
+	Reverse      bool       // Turn around on the next tick (mode switch)
+	House        HouseState // Inside, leaving or entering the ghost house
+	DotCounter   int        // Pellets counted towards leaving the house
+	Elroy        int        // Cruise Elroy stage (Blinky only): 0 off, 1 or 2
 }
 
-func NewGhost(gtype GhostType, x, y int) *Ghost {
-	return &Ghost{
-		Type: gtype,
-		X:    x,
-		Y:    y,
-		Dir:  DirLeft,
-		Mode: ModeScatter,
-	}
+// NewGhost creates a ghost at its start position: Blinky above the ghost
+// house door, the others waiting inside.
+func NewGhost(gtype GhostType) *Ghost {
+	g := &Ghost{Type: gtype}
+	g.ResetToStart()
+	return g
 }
 
-func (g *Ghost) Update(maze *Maze, pacman *Pacman, level int) {
-	g.AnimFrame++
+// ResetToStart puts the ghost back at its start position in scatter mode.
+// Its dot counter is kept; the Sim decides when to clear it.
+func (g *Ghost) ResetToStart() {
+	x, y, house := ghostStart(g.Type)
+	g.SetTile(x, y)
+	g.House = house
+	g.Dir = DirLeft
+	g.Mode = ModeScatter
+	g.SpeedCredit = 0
+	g.RespawnTimer = 0
+	g.Reverse = false
+}
 
-	// Handle eaten mode - return to ghost house
-	if g.Mode == ModeEaten {
-		// Check if close to target entrance (within 3 tiles - more forgiving)
-		dx := g.X - g.TargetX
-		if dx < 0 {
-			dx = -dx
-		}
-		dy := g.Y - g.TargetY
-		if dy < 0 {
-			dy = -dy
-		}
+// GhostEnv is everything a ghost's AI can see of the world on one tick.
+type GhostEnv struct {
+	Maze   *Maze
+	Pacman *Pacman
+	Ghosts []*Ghost // Every ghost, including the one being updated
+	Level  int
+	Mode   GhostMode // Scatter/chase mode the level schedule asks for
+	RNG    *RNG      // Source for any random choice
+	Rules  Rules
+}
 
-		// Respawn when close to ghost house entrance
-		if dx <= 2 && dy <= 2 {
-			// Teleport inside ghost house and respawn
-			g.X = 14
-			g.Y = 14
-			g.Mode = ModeScatter
-			g.RespawnTimer = 16 // ~2 second immunity at 8 FPS
-			return
-		}
-		// Eyes move faster
-		g.MoveTick++
-		if g.MoveTick < 1 { // Very fast
-			return
+// Ghost returns the ghost of type t, or nil if it isn't in play.
+func (env *GhostEnv) Ghost(t GhostType) *Ghost {
+	for _, ghost := range env.Ghosts {
+		if ghost.Type == t {
+			return ghost
 		}
-		g.MoveTick = 0
-		g.Dir = g.chooseReturnDirection(maze, g.TargetX, g.TargetY)
-		nx, ny := g.getNextPos(g.Dir)
-		if maze.IsWalkableForGhost(nx, ny) {
-			g.X = nx
-			g.Y = ny
-		}
-		return
 	}
+	return nil
+}
+
+// Update advances the ghost by one tick.
+func (g *Ghost) Update(env *GhostEnv) {
+	maze, pacman, mode := env.Maze, env.Pacman, env.Mode
+
+	g.AnimFrame++
 
 	// Decrement respawn immunity timer
 	if g.RespawnTimer > 0 {
 		g.RespawnTimer--
 	}
 
-	// Update mode based on pacman power mode (but not if eaten)
-	// Note: RespawnTimer doesn't prevent becoming frightened, only prevents immediate re-frightening
-	if pacman.PowerMode && g.Mode != ModeFrightened && g.Mode != ModeEaten {
-		g.Mode = ModeFrightened
-	} else if !pacman.PowerMode && g.Mode == ModeFrightened {
-		g.Mode = ModeChase
+	// Frightened ghosts recover when power mode ends. Only ghosts around
+	// when the power pellet was eaten are frightened (see Frighten), so
+	// ghosts revived meanwhile stay dangerous.
+	if !pacman.PowerMode && g.Mode == ModeFrightened {
+		g.Mode = mode
 	}
 
-	// Movement with speed control - SLOWER when frightened, FASTER at higher levels
-	speed := BaseGhostSpeed
-	if level > 1 {
-		speed = speed - (level-1)/3 // Get faster every 3 levels
-		if speed < 1 {
-			speed = 1
-		}
-	}
-	if g.Mode == ModeFrightened {
-		speed = speed + 2 // Slower when frightened
+	// Turn around straight away after a mode switch
+	if g.Reverse {
+		g.Reverse = false
+		g.Dir = oppositeDir(g.Dir)
 	}
 
-	g.MoveTick++
-	if g.MoveTick < speed {
-		return
+	for steps := g.pixelsThisTick(g.speed(env)); steps > 0; steps-- {
+		switch {
+		case g.House == HouseEntering:
+			// Returning eyes drop through the door to revive inside the house
+			g.stepEntering(maze, mode)
+
+		case g.House != HouseOutside:
+			g.stepInHouse(maze, mode)
+
+		case g.Mode == ModeEaten:
+			// Eyes head back to the tile above the door, then enter the house
+			if g.AtTileCenter() {
+				if g.X == g.TargetX && g.Y == g.TargetY {
+					g.House = HouseEntering
+					continue
+				}
+				g.Dir = g.chooseReturnDirection(maze, g.TargetX, g.TargetY)
+			}
+			g.step(g.Dir, maze)
+
+		default:
+			// Ghosts only pick a new direction on a tile center
+			if g.AtTileCenter() {
+				g.Dir = g.chooseDirection(env)
+
+				// Only eyes and ghosts leaving the house may pass the door
+				nx, ny := g.nextTile(g.Dir, maze)
+				if !maze.IsWalkable(nx, ny) {
+					g.SpeedCredit = 0
+					return
+				}
+			}
+			g.step(g.Dir, maze)
+		}
 	}
-	g.MoveTick = 0
-
-	// Choose direction with simple AI
-	g.Dir = g.chooseDirection(maze, pacman)
-
-	// Move
-	nx, ny := g.getNextPos(g.Dir)
+}
 
-	// Tunnel wraparound
-	if nx < 0 {
-		nx = maze.Width - 1
-	} else if nx >= maze.Width {
-		nx = 0
+// speed returns how fast the ghost moves this tick, in percent of full speed.
+func (g *Ghost) speed(env *GhostEnv) int {
+	speeds := speedsForLevel(env.Level)
+	switch {
+	case g.Mode == ModeEaten || g.House == HouseEntering:
+		return eyesSpeed
+	case g.House != HouseOutside:
+		return houseSpeed
+	case env.Maze.IsTunnel(g.X, g.Y):
+		return speeds.GhostTunnel
+	case g.Mode == ModeFrightened:
+		return speeds.GhostFright
+	case g.Elroy == 2:
+		return speeds.Elroy2
+	case g.Elroy == 1:
+		return speeds.Elroy1
 	}
+	return speeds.Ghost
+}
 
-	if maze.IsWalkableForGhost(nx, ny) {
-		g.X = nx
-		g.Y = ny
+// Frighten turns the ghost blue when Pac-Man eats a power pellet. Ghosts
+// in the maze turn around, as in the arcade; eyes are unaffected.
+func (g *Ghost) Frighten() {
+	if g.Mode == ModeEaten {
+		return
+	}
+	g.Mode = ModeFrightened
+	if g.House == HouseOutside {
+		g.Reverse = true
 	}
 }
 
-func (g *Ghost) chooseDirection(maze *Maze, pacman *Pacman) Direction {
+func (g *Ghost) chooseDirection(env *GhostEnv) Direction {
+	maze, pacman := env.Maze, env.Pacman
 	var targetX, targetY int
 
 	if g.Mode == ModeFrightened {
-		// Run away from pacman
-		targetX = g.X*2 - pacman.X
-		targetY = g.Y*2 - pacman.Y
+		return g.chooseFrightenedDirection(env)
+	} else if g.Mode == ModeScatter && g.Elroy == 0 {
+		// Head for this ghost's home corner (Elroy keeps chasing)
+		targetX, targetY = g.ScatterTarget()
 	} else {
 		// Chase pacman with ghost-specific behavior
 		targetX = pacman.X
@@ -245,27 +323,21 @@ func (g *Ghost) chooseDirection(maze *Maze, pacman *Pacman) Direction {
 
 		switch g.Type {
 		case GhostPinky:
-			// Target ahead of pacman
-			switch pacman.Dir {
-			case DirUp:
-				targetY -= 4
-			case DirDown:
-				targetY += 4
-			case DirLeft:
-				targetX -= 4
-			case DirRight:
-				targetX += 4
-			}
+			// Target four tiles ahead of pacman
+			targetX, targetY = tileAhead(pacman, 4, env.Rules.UpOverflowBug)
 		case GhostInky:
-			// Flanking behavior
-			targetX = 2*pacman.X - g.X
-			targetY = 2*pacman.Y - g.Y
+			// Flank: double the vector from Blinky to two tiles ahead of pacman
+			aheadX, aheadY := tileAhead(pacman, 2, env.Rules.UpOverflowBug)
+			targetX, targetY = aheadX, aheadY
+			if blinky := env.Ghost(GhostBlinky); blinky != nil {
+				targetX = 2*aheadX - blinky.X
+				targetY = 2*aheadY - blinky.Y
+			}
 		case GhostClyde:
-			// Random when close
+			// Retreat to his corner when close
 			dist := math.Abs(float64(g.X-pacman.X)) + math.Abs(float64(g.Y-pacman.Y))
 			if dist < 8 {
-				targetX = 0
-				targetY = maze.Height - 1
+				targetX, targetY = g.ScatterTarget()
 			}
 		}
 	}
@@ -277,23 +349,18 @@ func (g *Ghost) chooseDirection(maze *Maze, pacman *Pacman) Direction {
 	reverseDist := math.MaxFloat64
 
 	for _, dir := range []Direction{DirUp, DirDown, DirLeft, DirRight} {
-		nx, ny := g.X, g.Y
-		switch dir {
-		case DirUp:
-			ny--
-		case DirDown:
-			ny++
-		case DirLeft:
-			nx--
-		case DirRight:
-			nx++
+		nx, ny := g.nextTile(dir, maze)
+		if !maze.IsWalkable(nx, ny) {
+			continue
 		}
-
-		if !maze.IsWalkableForGhost(nx, ny) {
+		if dir == DirUp && maze.NoUpTurn(g.X, g.Y) {
+			// Restricted intersection; frightened ghosts are exempt
 			continue
 		}
 
-		dist := math.Abs(float64(nx-targetX)) + math.Abs(float64(ny-targetY))
+		// Straight-line distance, as the arcade measures it
+		dx, dy := float64(nx-targetX), float64(ny-targetY)
+		dist := dx*dx + dy*dy
 
 		if dir == oppositeDir(g.Dir) {
 			// Save reverse as backup
@@ -322,19 +389,63 @@ func (g *Ghost) chooseDirection(maze *Maze, pacman *Pacman) Direction {
 	return bestDir
 }
 
-func (g *Ghost) getNextPos(dir Direction) (int, int) {
-	nx, ny := g.X, g.Y
-	switch dir {
+// chooseFrightenedDirection picks a pseudo-random turn like the arcade:
+// a random direction is tried first, then the others in the order up,
+// left, down, right, never reversing unless it's the only way out.
+func (g *Ghost) chooseFrightenedDirection(env *GhostEnv) Direction {
+	order := []Direction{DirUp, DirLeft, DirDown, DirRight}
+	start := env.RNG.Intn(len(order))
+	for i := range order {
+		dir := order[(start+i)%len(order)]
+		if dir == oppositeDir(g.Dir) {
+			continue
+		}
+		nx, ny := g.nextTile(dir, env.Maze)
+		if env.Maze.IsWalkable(nx, ny) {
+			return dir
+		}
+	}
+
+	if reverse := oppositeDir(g.Dir); reverse != DirNone {
+		return reverse
+	}
+	return g.Dir
+}
+
+// tileAhead returns the tile n tiles in front of Pac-Man. With upBug set it
+// reproduces the arcade's overflow bug, where facing up also shifts the
+// tile n tiles to the left.
+func tileAhead(pacman *Pacman, n int, upBug bool) (int, int) {
+	x, y := pacman.X, pacman.Y
+	switch pacman.Dir {
 	case DirUp:
-		ny--
+		y -= n
+		if upBug {
+			x -= n
+		}
 	case DirDown:
-		ny++
+		y += n
 	case DirLeft:
-		nx--
+		x -= n
 	case DirRight:
-		nx++
+		x += n
+	}
+	return x, y
+}
+
+// ScatterTarget returns the ghost's home corner tile. As in the arcade the
+// targets lie outside the maze, so ghosts circle the nearest corner block.
+func (g *Ghost) ScatterTarget() (int, int) {
+	switch g.Type {
+	case GhostBlinky:
+		return MazeWidthTiles - 3, -3 // Top right
+	case GhostPinky:
+		return 2, -3 // Top left
+	case GhostInky:
+		return MazeWidthTiles - 1, MazeHeightTiles // Bottom right
+	default:
+		return 0, MazeHeightTiles // Bottom left (Clyde)
 	}
-	return nx, ny
 }
 
 func (g *Ghost) GetColor() color.RGBA {
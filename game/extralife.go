@@ -0,0 +1,65 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ExtraLife configures when Pac-Man is awarded a bonus life.
+type ExtraLife struct {
+	Score int  // Score that awards the bonus life; 0 means no bonus lives
+	Every bool // Award another life every Score points, not just once
+}
+
+// ParseExtraLife parses an extra-life rule: "none", a score such as "10000"
+// for a single bonus life, or "every:N" for a life every N points.
+func ParseExtraLife(s string) (ExtraLife, error) {
+	if s == "none" {
+		return ExtraLife{}, nil
+	}
+
+	rest, every := strings.CutPrefix(s, "every:")
+	score, err := strconv.Atoi(rest)
+	if err != nil || score <= 0 {
+		return ExtraLife{}, fmt.Errorf("invalid extra life rule %q: want none, a score, or every:N", s)
+	}
+	return ExtraLife{Score: score, Every: every}, nil
+}
+
+// String describes the rule for the HUD and start screen.
+func (e ExtraLife) String() string {
+	switch {
+	case e.Score <= 0:
+		return "none"
+	case e.Every:
+		return fmt.Sprintf("every %d points", e.Score)
+	}
+	return fmt.Sprintf("at %d points", e.Score)
+}
+
+// Next returns the score of the next bonus life once awarded lives have
+// already been given, or 0 if there are no more to come.
+func (e ExtraLife) Next(awarded int) int {
+	switch {
+	case e.Score <= 0:
+		return 0
+	case e.Every:
+		return e.Score * (awarded + 1)
+	case awarded == 0:
+		return e.Score
+	}
+	return 0
+}
+
+// checkExtraLife awards any bonus lives the score has reached.
+func (s *Sim) checkExtraLife() {
+	for {
+		next := s.rules.ExtraLife.Next(s.livesAwarded)
+		if next == 0 || s.score < next {
+			return
+		}
+		s.livesAwarded++
+		s.lives++
+	}
+}
@@ -2,31 +2,71 @@ package game
 
 import "image/color"
 
+// Pellets eaten in a level at which the bonus fruit appears
+var fruitPelletCounts = []int{70, 170}
+
+// How many recent levels' fruit the HUD shows
+const recentFruitCount = 7
+
 type Fruit struct {
 	X         int
 	Y         int
 	Type      FruitType
 	Active    bool
 	Eaten     bool
-	SpawnTime int
+	SpawnTime int // Ticks the fruit has been on screen
+	Duration  int // Ticks it stays before disappearing
 }
 
-func NewFruit(level int) *Fruit {
-	// Fruit type based on level (classic Pac-Man progression)
-	fruitType := FruitType(level - 1)
-	if fruitType > FruitKey {
-		fruitType = FruitKey
-	}
-
+// NewFruit creates the bonus fruit for level. Any random choice must come from rng.
+func NewFruit(level int, rng *RNG) *Fruit {
 	return &Fruit{
 		X:      14,
 		Y:      17,
-		Type:   fruitType,
+		Type:   fruitForLevel(level),
 		Active: true,
 		Eaten:  false,
+		// Stays between 9 and 10 seconds
+		Duration: 9*TicksPerSecond + rng.Intn(TicksPerSecond+1),
 	}
 }
 
+// fruitForLevel returns the arcade's bonus fruit for level
+func fruitForLevel(level int) FruitType {
+	switch {
+	case level <= 1:
+		return FruitCherry
+	case level == 2:
+		return FruitStrawberry
+	case level <= 4:
+		return FruitOrange
+	case level <= 6:
+		return FruitApple
+	case level <= 8:
+		return FruitMelon
+	case level <= 10:
+		return FruitGalaxian
+	case level <= 12:
+		return FruitBell
+	default:
+		return FruitKey
+	}
+}
+
+// RecentFruits returns the fruit of up to the last seven levels, ending
+// with level, for the HUD's fruit row
+func RecentFruits(level int) []FruitType {
+	first := level - recentFruitCount + 1
+	if first < 1 {
+		first = 1
+	}
+	fruits := make([]FruitType, 0, recentFruitCount)
+	for l := first; l <= level; l++ {
+		fruits = append(fruits, fruitForLevel(l))
+	}
+	return fruits
+}
+
 func (f *Fruit) GetColor() color.RGBA {
 	switch f.Type {
 	case FruitCherry:
@@ -51,7 +91,34 @@ func (f *Fruit) GetColor() color.RGBA {
 }
 
 func (f *Fruit) GetSymbol() string {
-	switch f.Type {
+	return f.Type.Symbol()
+}
+
+// Name returns the fruit's name in capitals, for ASCII-only displays
+func (t FruitType) Name() string {
+	switch t {
+	case FruitStrawberry:
+		return "STRAWBERRY"
+	case FruitOrange:
+		return "ORANGE"
+	case FruitApple:
+		return "APPLE"
+	case FruitMelon:
+		return "MELON"
+	case FruitGalaxian:
+		return "GALAXIAN"
+	case FruitBell:
+		return "BELL"
+	case FruitKey:
+		return "KEY"
+	default:
+		return "CHERRY"
+	}
+}
+
+// Symbol returns the emoji used for the fruit in terminal text
+func (t FruitType) Symbol() string {
+	switch t {
 	case FruitCherry:
 		return "🍒"
 	case FruitStrawberry:
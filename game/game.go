@@ -4,100 +4,107 @@ import (
 	"fmt"
 	"image"
 	"os"
+	"os/signal"
+	"strings"
 	"time"
 
 	"github.com/eiannone/keyboard"
-	"github.com/mattn/go-sixel"
-	"golang.org/x/term"
 )
 
 type Game struct {
+	sim           *Sim
 	renderer      *Renderer
-	maze          *Maze
-	pacman        *Pacman
-	ghosts        []*Ghost
-	state         GameState
-	score         int
-	lives         int
-	level         int
+	display       Display
+	input         Input // Input collected since the last tick
 	scale         int
 	width         int
 	height        int
-	frame         int
-	fruit         *Fruit
-	fruitTimer    int
 	lastFrameTime time.Time
 	fps           float64
+	keyboardOpen  bool
+	recording     *Replay // Key events recorded for --record, or nil
+	recordPath    string
+	replay        *Replay // Replay being played back, or nil
+	replayPos     int     // Next event in replay to apply
+	attract       attractMode
+	inGame        bool // A game, not the title, is on screen
+	paused        bool // P: the Sim is not stepped
+	stepOnce      bool // N while paused: run exactly one tick
+	slowMotion    bool // M while paused: step only every slowMotionFactor ticks
+	slowTicks     int  // Ticks counted towards the next slow-motion step
 }
 
-// GhostPos stores a ghost's previous position for collision detection
-type GhostPos struct {
-	prevX, prevY int
+// slowMotionFactor is how many times slower slow motion runs
+const slowMotionFactor = 4
+
+// Options configures a Game.
+type Options struct {
+	Seed       *int64  // RNG seed; nil picks one from the clock
+	Rules      Rules   // Gameplay rules for this run
+	Version    string  // Game version stored in recordings
+	RecordPath string  // Write a replay of this run here on exit
+	Replay     *Replay // Play this replay back instead of reading the keyboard
+	Display    string  // Display backend, as named by DisplayNames; "" means AutoDisplay
 }
 
-func NewGame() (*Game, error) {
-	// Initialize keyboard
-	if err := keyboard.Open(); err != nil {
+func NewGame(opts Options) (*Game, error) {
+	if opts.Display == "" {
+		opts.Display = AutoDisplay
+	}
+	// Ask the terminal what it can show, if the display cares, before the
+	// keyboard takes its input
+	var caps TermCaps
+	if displaysUsingCaps[opts.Display] {
+		var err error
+		if caps, err = DetectTermCaps(); err != nil {
+			return nil, fmt.Errorf("detecting terminal graphics: %w", err)
+		}
+	}
+	display, err := NewDisplay(opts.Display, os.Stdout, caps)
+	if err != nil {
 		return nil, err
 	}
 
-	// Calculate scale
-	scale := calculateScale()
-
-	game := &Game{
-		renderer:   NewRenderer(scale),
-		maze:       NewMaze(),
-		pacman:     NewPacman(),
-		state:      StateStart,
-		score:      0,
-		lives:      InitialLives,
-		level:      1,
-		scale:      scale,
-		width:      BaseWidth * scale,
-		height:     BaseHeight * scale,
-		frame:      0,
-		fruit:      nil,
-		fruitTimer: 0,
-	}
-
-	// Create ghosts at starting positions (all outside house, spread out)
-	game.ghosts = []*Ghost{
-		NewGhost(GhostBlinky, 14, 11), // Center
-		NewGhost(GhostPinky, 12, 11),  // Left
-		NewGhost(GhostInky, 16, 11),   // Right
-		NewGhost(GhostClyde, 14, 9),   // Above
+	// Initialize keyboard (replays never read it)
+	if opts.Replay == nil {
+		if err := keyboard.Open(); err != nil {
+			return nil, err
+		}
 	}
 
-	return game, nil
-}
+	scale := display.Scale()
 
-func calculateScale() int {
-	width, height, err := term.GetSize(int(os.Stdout.Fd()))
-	if err != nil {
-		return 2
-	}
-	pixelWidth := width * 8
-	pixelHeight := height * 16
-	scaleWidth := pixelWidth / BaseWidth
-	scaleHeight := pixelHeight / BaseHeight
-	scale := scaleWidth
-	if scaleHeight < scaleWidth {
-		scale = scaleHeight
+	seed, rules := time.Now().UnixNano(), opts.Rules
+	if opts.Replay != nil {
+		seed, rules = opts.Replay.Seed, opts.Replay.Rules
+	} else if opts.Seed != nil {
+		seed = *opts.Seed
 	}
-	if scale < 1 {
-		scale = 1
+
+	game := &Game{
+		sim:          NewSim(seed, rules),
+		renderer:     NewRenderer(scale),
+		display:      display,
+		scale:        scale,
+		width:        BaseWidth * scale,
+		height:       BaseHeight * scale,
+		keyboardOpen: opts.Replay == nil,
+		replay:       opts.Replay,
 	}
-	if scale > 5 {
-		scale = 5
+
+	if opts.RecordPath != "" {
+		game.recording = &Replay{Version: opts.Version, SimVersion: SimVersion, Seed: seed, Rules: rules}
+		game.recordPath = opts.RecordPath
 	}
-	return scale
+
+	return game, nil
 }
 
 func (g *Game) Run() {
 	// Setup terminal - alternate screen buffer and hide cursor
 	fmt.Print("\033[?1049h") // Enter alternate screen
 	fmt.Print("\033[?25l")   // Hide cursor
-	fmt.Print("\033[2J")     // Clear screen
+	g.clearScreen()
 
 	// Render start screen once
 	g.renderStartScreen()
@@ -106,19 +113,28 @@ func (g *Game) Run() {
 	ticker := time.NewTicker(time.Second / time.Duration(TicksPerSecond))
 	defer ticker.Stop()
 
-	// Keyboard input goroutine
+	// Keyboard input goroutine (replays feed recorded events instead)
 	keyChan := make(chan keyboard.KeyEvent, 100) // Larger buffer for better responsiveness
-	go func() {
-		for {
-			char, key, err := keyboard.GetKey()
-			if err == nil {
-				keyChan <- keyboard.KeyEvent{Rune: char, Key: key}
+	if g.replay == nil {
+		go func() {
+			for {
+				char, key, err := keyboard.GetKey()
+				if err == nil {
+					keyChan <- keyboard.KeyEvent{Rune: char, Key: key}
+				}
 			}
-		}
-	}()
+		}()
+	}
+
+	// The keyboard isn't in raw mode during replays, so Ctrl+C arrives as a signal
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt)
+	defer signal.Stop(sigChan)
 
 	for {
 		select {
+		case <-sigChan:
+			return
 		case ev := <-keyChan:
 			if g.handleInput(ev) {
 				return // Quit
@@ -137,6 +153,20 @@ func (g *Game) Run() {
 				}
 			}
 
+			// Feed recorded events stamped with this tick
+			if g.replay != nil {
+				if g.replayPos >= len(g.replay.Events) {
+					return // Replay finished
+				}
+				for g.replayPos < len(g.replay.Events) && g.replay.Events[g.replayPos].Tick <= g.sim.Frame() {
+					ev := g.replay.Events[g.replayPos].Event
+					g.replayPos++
+					if g.handleInput(ev) {
+						return // Recorded quit
+					}
+				}
+			}
+
 			// Calculate FPS
 			now := time.Now()
 			if !g.lastFrameTime.IsZero() {
@@ -147,364 +177,367 @@ func (g *Game) Run() {
 			}
 			g.lastFrameTime = now
 
-			g.update()
-			g.render()
-			g.frame++
-		}
-	}
-}
-
-func (g *Game) handleInput(ev keyboard.KeyEvent) bool {
-	// Check for quit keys
-	if ev.Key == keyboard.KeyEsc || ev.Key == keyboard.KeyCtrlC || ev.Rune == 'q' {
-		return true // Quit
-	}
+			// Paused or in slow motion: hold the Sim unless single-stepping
+			if !g.stepOnce {
+				if g.paused {
+					g.render(g.sim.Snapshot())
+					continue
+				}
+				if g.slowMotion {
+					g.slowTicks++
+					if g.slowTicks < slowMotionFactor {
+						continue
+					}
+					g.slowTicks = 0
+				}
+			}
+			g.stepOnce = false
 
-	// Handle start screen
-	if g.state == StateStart {
-		if ev.Key == keyboard.KeySpace {
-			g.state = StatePlaying
-		}
-		return false
-	}
+			g.sim.Step(g.input)
+			g.input = Input{}
 
-	// Handle game over state
-	if g.state == StateGameOver {
-		if ev.Rune == 'r' || ev.Rune == 'R' {
-			g.Reset() // Retry
-			return false
-		}
-		return false // Stay in game over until quit or retry
-	}
-
-	// Handle playing state
-	if g.state == StatePlaying {
-		switch ev.Key {
-		case keyboard.KeyArrowUp:
-			g.pacman.SetDirection(DirUp)
-		case keyboard.KeyArrowDown:
-			g.pacman.SetDirection(DirDown)
-		case keyboard.KeyArrowLeft:
-			g.pacman.SetDirection(DirLeft)
-		case keyboard.KeyArrowRight:
-			g.pacman.SetDirection(DirRight)
+			// Nobody is playing: run the attract loop instead
+			if g.sim.State() == StateStart {
+				// An unanswered GAME OVER goes back to the title
+				if g.inGame {
+					g.inGame = false
+					g.stopAttract()
+				}
+				g.updateAttract()
+				continue
+			}
+			if g.attract.phase != attractTitle {
+				g.attract.setPhase(attractTitle)
+				g.clearScreen()
+			}
+			g.inGame = true
+			g.render(g.sim.Snapshot())
 		}
 	}
-	return false
 }
 
-func (g *Game) update() {
-	if g.state != StatePlaying {
-		return
-	}
-
-	// Spawn fruit periodically
-	g.fruitTimer++
-	if g.fruit == nil && g.fruitTimer > 600 { // Every 10 seconds
-		g.fruit = NewFruit(g.level)
-		g.fruitTimer = 0
-	}
-
-	// Despawn fruit after 8 seconds
-	if g.fruit != nil && g.fruit.Active && !g.fruit.Eaten {
-		g.fruit.SpawnTime++
-		if g.fruit.SpawnTime > 480 { // 8 seconds
-			g.fruit = nil
-			g.fruitTimer = 0
-		}
-	}
-
-	// Store Pac-Man's previous position for collision detection
-	prevPacX, prevPacY := g.pacman.X, g.pacman.Y
-
-	// Update Pacman
-	g.pacman.Update(g.maze)
-
-	// Check pellet eating
-	isPower, ate := g.maze.EatPellet(g.pacman.X, g.pacman.Y)
-	if ate {
-		if isPower {
-			g.score += PowerPelletScore
-			g.pacman.ActivatePowerMode(g.level)
-		} else {
-			g.score += PelletScore
-		}
+// handleInput translates a key event into Input for the next tick.
+// It returns true when the player asked to quit.
+func (g *Game) handleInput(ev keyboard.KeyEvent) bool {
+	// Pause and debug keys only change how the Sim is stepped, so they are
+	// left out of recordings
+	if g.handleDebugKey(ev) {
+		return false
 	}
 
-	// Check fruit eating
-	if g.fruit != nil && g.fruit.Active && !g.fruit.Eaten {
-		if g.pacman.X == g.fruit.X && g.pacman.Y == g.fruit.Y {
-			g.score += FruitScores[g.fruit.Type]
-			g.fruit.Eaten = true
-			g.fruit = nil
-		}
+	if g.recording != nil {
+		g.recording.Record(g.sim.Frame(), ev)
 	}
 
-	// Update ghosts (pass level for difficulty scaling)
-	// Store previous positions for collision prevention
-	prevPositions := make([]GhostPos, len(g.ghosts))
-	for i, ghost := range g.ghosts {
-		prevPositions[i] = GhostPos{ghost.X, ghost.Y}
-		ghost.Update(g.maze, g.pacman, g.level)
+	// Check for quit keys
+	if ev.Key == keyboard.KeyEsc || ev.Key == keyboard.KeyCtrlC || ev.Rune == 'q' {
+		return true // Quit
 	}
 
-	// Prevent ghosts from overlapping - if two ghosts are at same position,
-	// move the second one back to its previous position
-	for i := 0; i < len(g.ghosts); i++ {
-		for j := i + 1; j < len(g.ghosts); j++ {
-			if g.ghosts[i].X == g.ghosts[j].X && g.ghosts[i].Y == g.ghosts[j].Y {
-				// Collision! Move the second ghost back
-				g.ghosts[j].X = prevPositions[j].prevX
-				g.ghosts[j].Y = prevPositions[j].prevY
-			}
-		}
+	// Any key keeps the title screen from going into attract mode
+	if g.attract.phase == attractTitle {
+		g.attract.ticks = 0
 	}
 
-	// Check collisions (with position swap detection)
-	g.checkCollisions(prevPacX, prevPacY, prevPositions)
-
-	// Check win (level complete)
-	if g.maze.RemainingPellets == 0 {
-		g.NextLevel()
+	switch {
+	case ev.Key == keyboard.KeySpace:
+		g.input.Start = true
+	case ev.Rune == 'r' || ev.Rune == 'R':
+		g.input.Retry = true // Retry (Game Over)
+	case ev.Key == keyboard.KeyEnter:
+		g.input.Skip = true // Skip intermission
+	case ev.Key == keyboard.KeyArrowUp:
+		g.input.Dir = DirUp
+	case ev.Key == keyboard.KeyArrowDown:
+		g.input.Dir = DirDown
+	case ev.Key == keyboard.KeyArrowLeft:
+		g.input.Dir = DirLeft
+	case ev.Key == keyboard.KeyArrowRight:
+		g.input.Dir = DirRight
 	}
+	return false
 }
 
-func (g *Game) NextLevel() {
-	g.level++
-	g.maze.Reset()
-
-	// Reset positions
-	g.pacman.X = 14
-	g.pacman.Y = 23
-	g.pacman.Dir = DirNone
-	g.pacman.NextDir = DirNone
-	g.pacman.PowerMode = false
-
-	// Reset ghosts (all outside house, spread out)
-	g.ghosts[0].X, g.ghosts[0].Y = 14, 11 // Blinky center
-	g.ghosts[1].X, g.ghosts[1].Y = 12, 11 // Pinky left
-	g.ghosts[2].X, g.ghosts[2].Y = 16, 11 // Inky right
-	g.ghosts[3].X, g.ghosts[3].Y = 14, 9  // Clyde above
-	for _, ghost := range g.ghosts {
-		ghost.Mode = ModeScatter
-		ghost.Dir = DirLeft
+// handleDebugKey handles pause (P) and, while paused, single-step (N) and
+// slow motion (M). It reports whether ev was one of them.
+func (g *Game) handleDebugKey(ev keyboard.KeyEvent) bool {
+	state := g.sim.State()
+	if state == StateStart {
+		return false
 	}
 
-	g.state = StatePlaying
-}
-
-func (g *Game) Reset() {
-	g.score = 0
-	g.lives = InitialLives
-	g.level = 1
-	g.maze.Reset()
-
-	// Reset positions
-	g.pacman.X = 14
-	g.pacman.Y = 23
-	g.pacman.Dir = DirNone
-	g.pacman.NextDir = DirNone
-	g.pacman.PowerMode = false
-
-	// Reset ghosts (all outside house, spread out)
-	g.ghosts[0].X, g.ghosts[0].Y = 14, 11 // Blinky center
-	g.ghosts[1].X, g.ghosts[1].Y = 12, 11 // Pinky left
-	g.ghosts[2].X, g.ghosts[2].Y = 16, 11 // Inky right
-	g.ghosts[3].X, g.ghosts[3].Y = 14, 9  // Clyde above
-	for _, ghost := range g.ghosts {
-		ghost.Mode = ModeScatter
-		ghost.Dir = DirLeft
-	}
-
-	g.state = StatePlaying
-}
-
-func (g *Game) checkCollisions(prevPacX, prevPacY int, prevGhostPos []GhostPos) {
-	for i, ghost := range g.ghosts {
-		// Check for collision - either at same position OR position swap (passing through each other)
-		collision := false
-
-		// Case 1: Same position now
-		if g.pacman.X == ghost.X && g.pacman.Y == ghost.Y {
-			collision = true
-		}
-
-		// Case 2: Position swap - Pac-Man moved to where ghost was, ghost moved to where Pac-Man was
-		if g.pacman.X == prevGhostPos[i].prevX && g.pacman.Y == prevGhostPos[i].prevY &&
-			ghost.X == prevPacX && ghost.Y == prevPacY {
-			collision = true
-		}
-
-		if collision {
-			if g.pacman.PowerMode && ghost.Mode == ModeFrightened {
-				// Eat ghost - becomes eyes and returns to ghost house ENTRANCE
-				g.score += GhostScore
-				ghost.Mode = ModeEaten
-				ghost.TargetX = 14
-				ghost.TargetY = 11 // Target entrance above ghost house, not inside
-			} else if ghost.Mode != ModeFrightened && ghost.Mode != ModeEaten {
-				// Pac-Man dies
-				g.lives--
-				g.pacman.X = 14
-				g.pacman.Y = 23
-				g.pacman.Dir = DirNone
-				if g.lives <= 0 {
-					g.state = StateGameOver
-				}
-			}
-		}
+	switch {
+	case ev.Rune == 'p' || ev.Rune == 'P':
+		g.paused = !g.paused
+	case g.paused && (ev.Rune == 'n' || ev.Rune == 'N'):
+		g.stepOnce = true
+	case g.paused && (ev.Rune == 'm' || ev.Rune == 'M'):
+		g.slowMotion = !g.slowMotion
+		g.slowTicks = 0
+	default:
+		return false
 	}
+	return true
 }
 
-func (g *Game) render() {
+func (g *Game) render(snap Snapshot) {
 	// Don't re-render start screen (already rendered once at startup)
-	if g.state == StateStart {
+	if snap.State == StateStart {
 		return
 	}
+	g.show(g.renderScreen(snap), &snap, g.hudLines(snap))
+}
 
-	// Create image buffer
-	screen := image.NewRGBA(image.Rect(0, 0, g.width, g.height))
+// renderScreen draws the game screen for snap. The ASCII display draws
+// from the snapshot alone, so it gets no pixels.
+func (g *Game) renderScreen(snap Snapshot) *image.RGBA {
+	if g.display.ASCIIOnly() {
+		return nil
+	}
+	screen := g.newScreen()
 
-	// Fill background with black (CRITICAL - test files do this!)
-	for y := 0; y < g.height; y++ {
-		for x := 0; x < g.width; x++ {
-			screen.Set(x, y, ColorBlack)
-		}
+	// Intermissions play on an empty screen
+	if snap.State == StateIntermission {
+		g.renderer.RenderCutscene(screen, snap.Cutscene, snap.Frame)
+		return screen
 	}
 
 	// Render maze (with level-based color)
-	g.renderer.RenderMaze(g.maze, screen, g.frame, g.level)
+	flash := snap.State == StateLevelClear && MazeFlashWhite(snap.StateTicks)
+	g.renderer.RenderMaze(snap.Maze, screen, snap.Frame, snap.Level, flash)
+
+	// Once the death animation starts, or the game is over, the actors are
+	// gone. Ghosts also vanish while a cleared maze flashes.
+	deathFrame := -1
+	if snap.State == StateDying {
+		deathFrame = DeathFrame(snap.StateTicks)
+	}
+	showActors := deathFrame < 0 && snap.State != StateGameOver
+	showGhosts := showActors &&
+		!(snap.State == StateLevelClear && snap.StateTicks >= levelClearFreezeTicks)
 
-	// Render Pacman
-	g.renderer.RenderPacman(screen, g.pacman.X, g.pacman.Y, g.pacman.Dir, g.pacman.AnimFrame)
+	// Render Pacman (hidden while an eaten ghost's points are shown)
+	if deathFrame >= 0 {
+		g.renderer.RenderPacmanDeath(screen, snap.Pacman.PX, snap.Pacman.PY, deathFrame)
+	} else if showActors && snap.Popup == nil {
+		g.renderer.RenderPacman(screen, snap.Pacman.PX, snap.Pacman.PY, snap.Pacman.Dir, snap.Pacman.AnimFrame)
+	}
 
 	// Render fruit
-	if g.fruit != nil && g.fruit.Active && !g.fruit.Eaten {
-		g.renderer.RenderFruit(screen, g.fruit)
+	if snap.Fruit != nil && snap.Fruit.Active && !snap.Fruit.Eaten {
+		g.renderer.RenderFruit(screen, snap.Fruit)
 	}
 
 	// Render ghosts
-	for _, ghost := range g.ghosts {
+	for _, ghost := range snap.Ghosts {
+		if !showGhosts || (snap.Popup != nil && ghost.Type == snap.Popup.Ghost) {
+			continue
+		}
 		frightened := ghost.Mode == ModeFrightened
 		blinking := false
 		isEyes := ghost.Mode == ModeEaten
 
-		// Check if power mode is ending (last ~2 seconds)
-		if frightened && g.pacman.PowerMode {
-			// Power mode lasts 48 frames (~6 seconds at 8 FPS)
-			// Blink during last ~2 seconds = last 16 frames
-			remainingFrames := g.pacman.PowerTimeLeft()
-			if remainingFrames < 16 {
-				blinking = true
-			}
+		// Blink as fright time runs out
+		if frightened && snap.Pacman.PowerMode && snap.Pacman.PowerTimeLeft() < frightBlinkTicks {
+			blinking = true
 		}
 
 		color := ghost.GetColor()
-		g.renderer.RenderGhost(screen, ghost.X, ghost.Y, color, frightened, blinking, isEyes, g.frame)
+		g.renderer.RenderGhost(screen, ghost.PX, ghost.PY, color, frightened, blinking, isEyes, snap.Frame)
+	}
+
+	// Points for the ghost just eaten
+	if snap.Popup != nil {
+		g.renderer.RenderPoints(screen, snap.Popup.PX, snap.Popup.PY, snap.Popup.Points, ColorInkyCyan)
 	}
 
 	// Render HUD
-	g.renderer.RenderHUD(screen, g.score, g.lives, g.level)
+	g.renderer.RenderHUD(screen, snap.Score, snap.Lives, snap.Level)
 
-	// Render game over message
-	switch g.state {
+	// Render READY! and game over messages
+	switch snap.State {
+	case StateReady:
+		g.renderer.RenderReady(screen)
 	case StateGameOver:
-		g.renderer.RenderGameOver(screen, false)
-	case StateWin:
-		g.renderer.RenderGameOver(screen, true)
+		g.renderer.RenderGameOver(screen)
+	}
+	if g.paused {
+		g.renderer.RenderPaused(screen)
 	}
 
-	// Output to terminal using Sixel
-	fmt.Print("\033[H") // Move cursor to home
-	enc := sixel.NewEncoder(os.Stdout)
-	if err := enc.Encode(screen); err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to encode screen: %v\n", err)
+	return screen
+}
+
+// hudLines returns the status lines shown below the game screen for snap.
+func (g *Game) hudLines(snap Snapshot) []string {
+	if snap.State == StateIntermission {
+		return []string{"", "\033[1;37m   Press ENTER to skip\033[0m"}
 	}
 
-	// Print HUD below the game screen
 	powerInfo := ""
-	if g.pacman.PowerMode {
-		powerInfo = fmt.Sprintf(" POWER: %d ", g.pacman.PowerTicks)
+	if snap.Pacman.PowerMode {
+		powerInfo = fmt.Sprintf(" POWER: %d ", snap.Pacman.PowerTicks)
+	}
+	extraInfo := ""
+	if snap.NextExtraLife > 0 {
+		extraInfo = fmt.Sprintf(" 1UP AT: %d ", snap.NextExtraLife)
+	}
+	lines := []string{
+		fmt.Sprintf("\033[1;33m SCORE: %-8d LIVES: %d    LEVEL: %d %s%s FPS: %.1f \033[0m", snap.Score, snap.Lives, snap.Level, powerInfo, extraInfo, g.fps),
+	}
+
+	// Recent fruit row, newest on the right as in the arcade
+	var fruitRow strings.Builder
+	for _, fruit := range RecentFruits(snap.Level) {
+		if g.display.ASCIIOnly() {
+			fruitRow.WriteString(fruit.Name())
+		} else {
+			fruitRow.WriteString(fruit.Symbol())
+		}
+		fruitRow.WriteByte(' ')
 	}
-	fmt.Printf("\n\033[1;33m SCORE: %-8d LIVES: %d    LEVEL: %d %s FPS: %.1f \033[0m\n", g.score, g.lives, g.level, powerInfo, g.fps)
+	lines = append(lines, " FRUIT: "+fruitRow.String())
 
-	// Print game state messages
-	switch g.state {
+	// Game state messages
+	if g.paused || g.slowMotion {
+		status, slow := "RUNNING", "off"
+		if g.paused {
+			status = "PAUSED"
+		}
+		if g.slowMotion {
+			slow = fmt.Sprintf("1/%d speed", slowMotionFactor)
+		}
+		lines = append(lines, fmt.Sprintf("\033[1;36m %s at tick %d | P pause/resume | N step one tick | M slow motion: %s\033[0m", status, snap.Frame, slow))
+	}
+	switch snap.State {
 	case StateGameOver:
-		fmt.Print("\033[1;31m")
-		fmt.Println("\n ═══════════════════════════════════════")
-		fmt.Println("         GAME OVER!")
-		fmt.Println(" ═══════════════════════════════════════")
-		fmt.Print("\033[1;37m")
-		fmt.Println("   Press R to Retry  |  Q to Quit")
-		fmt.Print("\033[0m")
-	case StateWin:
-		fmt.Print("\033[1;32m")
-		fmt.Println("\n ═══════════════════════════════════════")
-		fmt.Printf("      LEVEL %d COMPLETE!\n", g.level)
-		fmt.Println(" ═══════════════════════════════════════")
-		fmt.Print("\033[1;37m")
-		fmt.Println("      Starting next level...")
-		fmt.Print("\033[0m")
+		lines = append(lines,
+			"",
+			"\033[1;31m ═══════════════════════════════════════\033[0m",
+			"\033[1;31m         GAME OVER!\033[0m",
+			"\033[1;31m ═══════════════════════════════════════\033[0m",
+			"\033[1;37m   Press R to Retry  |  Q to Quit\033[0m",
+		)
+	case StateLevelClear:
+		lines = append(lines,
+			"",
+			"\033[1;32m ═══════════════════════════════════════\033[0m",
+			fmt.Sprintf("\033[1;32m      LEVEL %d COMPLETE!\033[0m", snap.Level),
+			"\033[1;32m ═══════════════════════════════════════\033[0m",
+			"\033[1;37m      Starting next level...\033[0m",
+		)
+	}
+	return lines
+}
+
+// show hands a rendered screen to the display. snap is nil for screens
+// outside a game, and screen too for text-only ones such as the title.
+func (g *Game) show(screen *image.RGBA, snap *Snapshot, hud []string) {
+	if err := g.display.Show(&Frame{Screen: screen, Snap: snap, HUD: hud}); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to show screen: %v\n", err)
+	}
+}
+
+// clearScreen blanks the terminal through the display, so it knows to
+// redraw everything on the next frame.
+func (g *Game) clearScreen() {
+	if err := g.display.Clear(); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to clear screen: %v\n", err)
 	}
 }
 
 func (g *Game) Cleanup() {
+	if err := g.display.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to close display: %v\n", err)
+	}
+
 	// Restore terminal state
 	fmt.Print("\033[?25h")   // Show cursor
 	fmt.Print("\033[?1049l") // Exit alternate screen
-	if err := keyboard.Close(); err != nil {
-		fmt.Fprintf(os.Stderr, "Failed to close keyboard: %v\n", err)
+	if g.keyboardOpen {
+		if err := keyboard.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to close keyboard: %v\n", err)
+		}
+	}
+	if g.recording != nil {
+		if err := g.recording.Save(g.recordPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to save replay: %v\n", err)
+		}
 	}
 }
 
+// Title screen logo, drawn with box characters or, on ASCII-only
+// displays, plain ASCII
+var (
+	titleLogo = []string{
+		"    ██████╗  █████╗  ██████╗    ███╗   ███╗ █████╗ ███╗   ██╗",
+		"    ██╔══██╗██╔══██╗██╔════╝    ████╗ ████║██╔══██╗████╗  ██║",
+		"    ██████╔╝███████║██║         ██╔████╔██║███████║██╔██╗ ██║",
+		"    ██╔═══╝ ██╔══██║██║         ██║╚██╔╝██║██╔══██║██║╚██╗██║",
+		"    ██║     ██║  ██║╚██████╗    ██║ ╚═╝ ██║██║  ██║██║ ╚████║",
+		"    ╚═╝     ╚═╝  ╚═╝ ╚═════╝    ╚═╝     ╚═╝╚═╝  ╚═╝╚═╝  ╚═══╝",
+	}
+	titleLogoASCII = []string{
+		`     ____   _    ____      __  __    _    _   _`,
+		`    |  _ \ / \  / ___|    |  \/  |  / \  | \ | |`,
+		`    | |_) / _ \| |   _____| |\/| | / _ \ |  \| |`,
+		`    |  __/ ___ \ |__|_____| |  | |/ ___ \| |\  |`,
+		`    |_| /_/   \_\____|    |_|  |_/_/   \_\_| \_|`,
+		``,
+	}
+)
+
+// renderStartScreen shows the title, controls and scoring through the
+// display as a text-only frame.
 func (g *Game) renderStartScreen() {
-	fmt.Print("\033[H") // Move cursor to home
-
-	fmt.Print("\033[1;36m") // Cyan color
-	fmt.Println(`
-    ██████╗  █████╗  ██████╗    ███╗   ███╗ █████╗ ███╗   ██╗
-    ██╔══██╗██╔══██╗██╔════╝    ████╗ ████║██╔══██╗████╗  ██║
-    ██████╔╝███████║██║         ██╔████╔██║███████║██╔██╗ ██║
-    ██╔═══╝ ██╔══██║██║         ██║╚██╔╝██║██╔══██║██║╚██╗██║
-    ██║     ██║  ██║╚██████╗    ██║ ╚═╝ ██║██║  ██║██║ ╚████║
-    ╚═╝     ╚═╝  ╚═╝ ╚═════╝    ╚═╝     ╚═╝╚═╝  ╚═╝╚═╝  ╚═══╝
-	`)
-	fmt.Print("\033[0m")
-
-	fmt.Println()
-	fmt.Print("\033[1;33m") // Yellow
-	fmt.Println("          🍒 CLASSIC 1980s ARCADE EDITION 🍒")
-	fmt.Print("\033[0m")
-
-	fmt.Println()
-	fmt.Println()
-	fmt.Print("\033[1;37m") // White
-	fmt.Println("  CONTROLS:")
-	fmt.Println("  ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-	fmt.Println("   ↑ ↓ ← →  : Move Pac-Man")
-	fmt.Println("   Q / ESC  : Quit Game")
-	fmt.Println("   R        : Retry (Game Over)")
-	fmt.Print("\033[0m")
-
-	fmt.Println()
-	fmt.Println()
-	fmt.Print("\033[1;32m") // Green
-	fmt.Println("  SCORING:")
-	fmt.Println("  ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-	fmt.Println("   Pellet        :    10 points")
-	fmt.Println("   Power Pellet  :    50 points")
-	fmt.Println("   Ghost         :   200 points")
-	fmt.Println("   Fruit         : 100-5000 points")
-	fmt.Print("\033[0m")
-
-	fmt.Println()
-	fmt.Println()
-	fmt.Print("\033[1;35m") // Magenta
-	fmt.Println("  ═══════════════════════════════════════════")
-	fmt.Println()
-	fmt.Println("       Press SPACE to Start!")
-	fmt.Println()
-	fmt.Println("  ═══════════════════════════════════════════")
-	fmt.Print("\033[0m")
+	ascii := g.display.ASCIIOnly()
+	logo, edition, arrows, rule, box := titleLogo, "          🍒 CLASSIC 1980s ARCADE EDITION 🍒", "   ↑ ↓ ← →  ", "━", "═"
+	if ascii {
+		logo, edition, arrows, rule, box = titleLogoASCII, "          CLASSIC 1980s ARCADE EDITION", "   ARROWS   ", "-", "="
+	}
+
+	var lines []string
+	colored := func(color string, text ...string) {
+		for _, line := range text {
+			lines = append(lines, color+line+"\033[0m")
+		}
+	}
+
+	lines = append(lines, "")
+	colored("\033[1;36m", logo...) // Cyan
+	lines = append(lines, "", "")
+	colored("\033[1;33m", edition) // Yellow
+
+	lines = append(lines, "", "")
+	colored("\033[1;37m", // White
+		"  CONTROLS:",
+		"  "+strings.Repeat(rule, 40),
+		arrows+": Move Pac-Man",
+		"   Q / ESC  : Quit Game",
+		"   R        : Retry (Game Over)",
+		"   ENTER    : Skip Intermission",
+		"   P        : Pause (then N: step one tick, M: slow motion)",
+	)
+
+	lines = append(lines, "", "")
+	colored("\033[1;32m", // Green
+		"  SCORING:",
+		"  "+strings.Repeat(rule, 40),
+		"   Pellet        :    10 points",
+		"   Power Pellet  :    50 points",
+		"   Ghosts        : 200-400-800-1600 points",
+		"   Fruit         : 100-5000 points",
+		fmt.Sprintf("   Extra Life    : %s", g.sim.rules.ExtraLife),
+	)
+
+	lines = append(lines, "", "")
+	colored("\033[1;35m", // Magenta
+		"  "+strings.Repeat(box, 43),
+		"",
+		"       Press SPACE to Start!",
+		"",
+		"  "+strings.Repeat(box, 43),
+	)
+
+	g.show(nil, nil, lines)
 }
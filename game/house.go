@@ -0,0 +1,181 @@
+package game
+
+// Ghost house geometry (tile coordinates). Ghosts pass the door in the
+// house's door column and revive in the middle of the house.
+const (
+	houseDoorX   = 14 // Column ghosts use to pass through the door
+	houseEntryY  = 11 // Tile just above the door, where Blinky starts
+	houseCenterY = 14 // Row the waiting ghosts sit on
+	houseBounce  = 4  // Pixels waiting ghosts bob up and down
+)
+
+// Ghost start positions: Blinky above the door, the others inside the house
+func ghostStart(gtype GhostType) (x, y int, house HouseState) {
+	switch gtype {
+	case GhostPinky:
+		return houseDoorX, houseCenterY, HouseWaiting
+	case GhostInky:
+		return houseDoorX - 2, houseCenterY, HouseWaiting
+	case GhostClyde:
+		return houseDoorX + 2, houseCenterY, HouseWaiting
+	default:
+		return houseDoorX, houseEntryY, HouseOutside
+	}
+}
+
+// Pellets a ghost must see eaten (while it is the first in line) before
+// it leaves the house on its own
+func personalDotLimit(gtype GhostType, level int) int {
+	switch {
+	case gtype == GhostInky && level == 1:
+		return 30
+	case gtype == GhostClyde && level == 1:
+		return 60
+	case gtype == GhostClyde && level == 2:
+		return 50
+	}
+	return 0
+}
+
+// Pellets eaten since Pac-Man lost a life before each ghost is released
+func globalDotLimit(gtype GhostType) int {
+	switch gtype {
+	case GhostPinky:
+		return 7
+	case GhostInky:
+		return 17
+	default:
+		return 32
+	}
+}
+
+// Ticks without a pellet being eaten before the next ghost is forced out
+func houseIdleLimit(level int) int {
+	if level >= 5 {
+		return 3 * TicksPerSecond
+	}
+	return 4 * TicksPerSecond
+}
+
+// HouseRelease holds the Sim's ghost-house release counters.
+type HouseRelease struct {
+	GlobalActive bool // Using the global counter (after a life is lost)
+	GlobalDots   int  // Pellets eaten since the global counter started
+	IdleTicks    int  // Ticks since Pac-Man last ate a pellet
+}
+
+// preferredHouseGhost returns the ghost next in line to leave the house
+// (Pinky, then Inky, then Clyde), or nil if none is waiting.
+func (s *Sim) preferredHouseGhost() *Ghost {
+	for _, gtype := range []GhostType{GhostPinky, GhostInky, GhostClyde} {
+		for _, ghost := range s.ghosts {
+			if ghost.Type == gtype && ghost.House == HouseWaiting {
+				return ghost
+			}
+		}
+	}
+	return nil
+}
+
+// onPelletEaten feeds the active dot counter and restarts the idle timer.
+func (s *Sim) onPelletEaten() {
+	s.house.IdleTicks = 0
+	if s.house.GlobalActive {
+		s.house.GlobalDots++
+		return
+	}
+	if ghost := s.preferredHouseGhost(); ghost != nil {
+		ghost.DotCounter++
+	}
+}
+
+// updateHouse releases the next waiting ghost when its dot counter or the
+// idle timer says so.
+func (s *Sim) updateHouse() {
+	s.house.IdleTicks++
+
+	ghost := s.preferredHouseGhost()
+	if ghost == nil {
+		return
+	}
+
+	release := false
+	if s.house.GlobalActive {
+		if s.house.GlobalDots >= globalDotLimit(ghost.Type) {
+			release = true
+			if ghost.Type == GhostClyde {
+				// Once Clyde is out, fall back to the personal counters
+				s.house.GlobalActive = false
+			}
+		}
+	} else if ghost.DotCounter >= personalDotLimit(ghost.Type, s.level) {
+		release = true
+	}
+
+	if s.house.IdleTicks >= houseIdleLimit(s.level) {
+		release = true
+		s.house.IdleTicks = 0
+	}
+
+	if release {
+		ghost.House = HouseLeaving
+	}
+}
+
+// stepInHouse moves a ghost that is waiting in or leaving the house by one
+// pixel. mode is the mode it takes on once it is out.
+func (g *Ghost) stepInHouse(maze *Maze, mode GhostMode) {
+	centerY := houseCenterY*TileSize + TileSize/2
+
+	switch g.House {
+	case HouseWaiting:
+		// Bob up and down in place
+		if g.Dir != DirUp && g.Dir != DirDown {
+			g.Dir = DirUp
+		}
+		if g.PY <= centerY-houseBounce {
+			g.Dir = DirDown
+		} else if g.PY >= centerY+houseBounce {
+			g.Dir = DirUp
+		}
+		g.step(g.Dir, maze)
+
+	case HouseLeaving:
+		// Settle on the middle row, line up with the door, then go
+		// straight up through it
+		doorX := houseDoorX*TileSize + TileSize/2
+		switch {
+		case g.PX != doorX && g.PY < centerY:
+			g.Dir = DirDown
+		case g.PX != doorX && g.PY > centerY:
+			g.Dir = DirUp
+		case g.PX < doorX:
+			g.Dir = DirRight
+		case g.PX > doorX:
+			g.Dir = DirLeft
+		default:
+			g.Dir = DirUp
+		}
+		g.step(g.Dir, maze)
+
+		if g.PX == doorX && g.PY == houseEntryY*TileSize+TileSize/2 {
+			g.House = HouseOutside
+			g.Dir = DirLeft
+			if g.Mode != ModeFrightened {
+				g.Mode = mode
+			}
+		}
+	}
+}
+
+// stepEntering moves returning eyes one pixel down into the house and
+// revives the ghost once it reaches the middle. It leaves again straight away.
+func (g *Ghost) stepEntering(maze *Maze, mode GhostMode) {
+	g.Dir = DirDown
+	g.step(g.Dir, maze)
+	if g.PY >= houseCenterY*TileSize+TileSize/2 {
+		g.Mode = mode
+		g.House = HouseLeaving
+		g.RespawnTimer = 2 * TicksPerSecond // Immunity after reviving
+	}
+}
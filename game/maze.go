@@ -36,6 +36,42 @@ var mazeLayout = []string{
 	"WWWWWWWWWWWWWWWWWWWWWWWWWWWW",
 }
 
+// Per-tile zones for mazeLayout: T=tunnel (ghosts slow down), U=ghosts
+// may not turn up here, -=none
+var mazeZones = []string{
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"------------U--U------------",
+	"----------------------------",
+	"----------------------------",
+	"TTTTTT----------------TTTTTT",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"------------U--U------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+	"----------------------------",
+}
+
 type CellType byte
 
 const (
@@ -46,37 +82,73 @@ const (
 	CellGhostDoor   CellType = 'G'
 )
 
+// TileZone flags special ghost rules for a tile.
+type TileZone byte
+
+const (
+	ZoneTunnel TileZone = 1 << iota // Ghosts slow down to tunnel speed
+	ZoneNoUp                        // Ghosts may not choose to turn up
+)
+
 type Maze struct {
-	Width            int
-	Height           int
-	Cells            [][]CellType
-	TotalPellets     int
-	RemainingPellets int
+	Width             int
+	Height            int
+	Cells             [][]CellType
+	Zones             [][]TileZone
+	TotalPellets      int
+	TotalPowerPellets int
+	RemainingPellets  int
+
+	layout, zoneLayout []string // Source the maze is rebuilt from on Reset
 }
 
 func NewMaze() *Maze {
-	height := len(mazeLayout)
-	width := len(mazeLayout[0])
+	return NewMazeFromLayout(mazeLayout, mazeZones)
+}
+
+// NewMazeFromLayout builds a maze from layout rows using the same cell
+// characters as mazeLayout. zones, which may be nil or shorter than the
+// layout, marks tunnel (T) and no-upward-turn (U) tiles.
+func NewMazeFromLayout(layout, zones []string) *Maze {
+	height := len(layout)
+	width := len(layout[0])
 
 	m := &Maze{
-		Width:  width,
-		Height: height,
-		Cells:  make([][]CellType, height),
+		Width:      width,
+		Height:     height,
+		Cells:      make([][]CellType, height),
+		Zones:      make([][]TileZone, height),
+		layout:     layout,
+		zoneLayout: zones,
 	}
 
-	pelletCount := 0
+	pelletCount, powerCount := 0, 0
 	for y := 0; y < height; y++ {
 		m.Cells[y] = make([]CellType, width)
+		m.Zones[y] = make([]TileZone, width)
 		for x := 0; x < width; x++ {
-			cell := CellType(mazeLayout[y][x])
+			cell := CellType(layout[y][x])
 			m.Cells[y][x] = cell
 			if cell == CellPellet || cell == CellPowerPellet {
 				pelletCount++
 			}
+			if cell == CellPowerPellet {
+				powerCount++
+			}
+
+			if y < len(zones) && x < len(zones[y]) {
+				switch zones[y][x] {
+				case 'T':
+					m.Zones[y][x] = ZoneTunnel
+				case 'U':
+					m.Zones[y][x] = ZoneNoUp
+				}
+			}
 		}
 	}
 
 	m.TotalPellets = pelletCount
+	m.TotalPowerPellets = powerCount
 	m.RemainingPellets = pelletCount
 
 	return m
@@ -100,6 +172,24 @@ func (m *Maze) IsWalkable(x, y int) bool {
 	return cell != CellWall && cell != CellGhostDoor
 }
 
+// Zone returns the zone flags of tile (x, y); tiles off the maze have none.
+func (m *Maze) Zone(x, y int) TileZone {
+	if y < 0 || y >= m.Height || x < 0 || x >= m.Width {
+		return 0
+	}
+	return m.Zones[y][x]
+}
+
+// IsTunnel reports whether ghosts slow down on tile (x, y).
+func (m *Maze) IsTunnel(x, y int) bool {
+	return m.Zone(x, y)&ZoneTunnel != 0
+}
+
+// NoUpTurn reports whether ghosts are barred from turning up on tile (x, y).
+func (m *Maze) NoUpTurn(x, y int) bool {
+	return m.Zone(x, y)&ZoneNoUp != 0
+}
+
 func (m *Maze) IsWalkableForGhost(x, y int) bool {
 	cell := m.GetCell(x, y)
 	return cell != CellWall
@@ -121,5 +211,19 @@ func (m *Maze) EatPellet(x, y int) (isPowerPellet bool, ate bool) {
 }
 
 func (m *Maze) Reset() {
-	*m = *NewMaze()
+	*m = *NewMazeFromLayout(m.layout, m.zoneLayout)
+}
+
+// Clone returns a deep copy of the maze.
+func (m *Maze) Clone() *Maze {
+	c := *m
+	c.Cells = make([][]CellType, len(m.Cells))
+	for y, row := range m.Cells {
+		c.Cells[y] = append([]CellType(nil), row...)
+	}
+	c.Zones = make([][]TileZone, len(m.Zones))
+	for y, row := range m.Zones {
+		c.Zones[y] = append([]TileZone(nil), row...)
+	}
+	return &c
 }
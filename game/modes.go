@@ -0,0 +1,76 @@
+package game
+
+// modeForever marks the last phase of a schedule, which never ends.
+const modeForever = -1
+
+// Scatter/chase phase lengths in ticks, alternating scatter, chase,
+// scatter, ... as in the arcade. The "1/60s" scatter phases of later
+// levels round up to a single tick.
+var (
+	modeScheduleLevel1 = []int{
+		7 * TicksPerSecond, 20 * TicksPerSecond,
+		7 * TicksPerSecond, 20 * TicksPerSecond,
+		5 * TicksPerSecond, 20 * TicksPerSecond,
+		5 * TicksPerSecond, modeForever,
+	}
+	modeScheduleLevel2to4 = []int{
+		7 * TicksPerSecond, 20 * TicksPerSecond,
+		7 * TicksPerSecond, 20 * TicksPerSecond,
+		5 * TicksPerSecond, 1033 * TicksPerSecond,
+		1, modeForever,
+	}
+	modeScheduleLevel5 = []int{
+		5 * TicksPerSecond, 20 * TicksPerSecond,
+		5 * TicksPerSecond, 20 * TicksPerSecond,
+		5 * TicksPerSecond, 1037 * TicksPerSecond,
+		1, modeForever,
+	}
+)
+
+func modeScheduleForLevel(level int) []int {
+	switch {
+	case level <= 1:
+		return modeScheduleLevel1
+	case level <= 4:
+		return modeScheduleLevel2to4
+	default:
+		return modeScheduleLevel5
+	}
+}
+
+// ModeSchedule is the level-wide scatter/chase timer shared by all ghosts.
+// It is paused while Pac-Man is powered up.
+type ModeSchedule struct {
+	Phase int // Index into the level's schedule; even = scatter, odd = chase
+	Ticks int // Ticks spent in the current phase
+}
+
+// Mode returns the mode ghosts should be in for the current phase.
+func (m *ModeSchedule) Mode() GhostMode {
+	if m.Phase%2 == 0 {
+		return ModeScatter
+	}
+	return ModeChase
+}
+
+// Tick advances the timer by one tick and reports whether the phase changed.
+func (m *ModeSchedule) Tick(level int) bool {
+	schedule := modeScheduleForLevel(level)
+	length := schedule[m.Phase]
+	if length == modeForever {
+		return false
+	}
+
+	m.Ticks++
+	if m.Ticks < length {
+		return false
+	}
+	m.Phase++
+	m.Ticks = 0
+	return true
+}
+
+// Reset restarts the schedule from the first scatter phase.
+func (m *ModeSchedule) Reset() {
+	*m = ModeSchedule{}
+}
@@ -0,0 +1,85 @@
+package game
+
+// Mover is the position and motion state shared by Pac-Man and the ghosts.
+// PX, PY is the authoritative pixel position; X, Y is the tile that pixel
+// lies in and is kept in sync by every method that moves the entity.
+type Mover struct {
+	X, Y        int // Tile position
+	PX, PY      int // Pixel position of the sprite's center
+	Dir         Direction
+	SpeedCredit int // Fraction of a pixel banked between ticks (1/256ths)
+}
+
+// SetTile places the mover on the center of tile (x, y).
+func (m *Mover) SetTile(x, y int) {
+	m.setPixel(x*TileSize+TileSize/2, y*TileSize+TileSize/2)
+}
+
+func (m *Mover) setPixel(px, py int) {
+	m.PX, m.PY = px, py
+	m.X, m.Y = px/TileSize, py/TileSize
+}
+
+// AtTileCenter reports whether the mover is exactly on its tile's center,
+// the only place it can change direction.
+func (m *Mover) AtTileCenter() bool {
+	return m.PX%TileSize == TileSize/2 && m.PY%TileSize == TileSize/2
+}
+
+// pixelsThisTick banks one tick of movement at speed (percent of full
+// speed) and returns how many whole pixels to move now.
+func (m *Mover) pixelsThisTick(speed int) int {
+	m.SpeedCredit += speed * fullSpeedFixed / 100
+	steps := m.SpeedCredit >> speedFixedShift
+	m.SpeedCredit -= steps << speedFixedShift
+	return steps
+}
+
+// step moves one pixel in dir, wrapping around the side tunnel.
+func (m *Mover) step(dir Direction, maze *Maze) {
+	px, py := m.PX, m.PY
+	switch dir {
+	case DirUp:
+		py--
+	case DirDown:
+		py++
+	case DirLeft:
+		px--
+	case DirRight:
+		px++
+	}
+
+	// Tunnel wraparound
+	width := maze.Width * TileSize
+	if px < 0 {
+		px += width
+	} else if px >= width {
+		px -= width
+	}
+
+	m.setPixel(px, py)
+}
+
+// nextTile returns the tile next to the mover's tile in dir, wrapping
+// around the side tunnel.
+func (m *Mover) nextTile(dir Direction, maze *Maze) (int, int) {
+	nx, ny := m.X, m.Y
+	switch dir {
+	case DirUp:
+		ny--
+	case DirDown:
+		ny++
+	case DirLeft:
+		nx--
+	case DirRight:
+		nx++
+	}
+
+	// Tunnel wraparound
+	if nx < 0 {
+		nx = maze.Width - 1
+	} else if nx >= maze.Width {
+		nx = 0
+	}
+	return nx, ny
+}
@@ -3,6 +3,7 @@ package game
 import (
 	"image"
 	"image/color"
+	"strconv"
 
 	"pacman/sprites"
 )
@@ -16,13 +17,17 @@ func NewRenderer(scale int) *Renderer {
 	return &Renderer{scale: scale}
 }
 
-// RenderMaze renders the entire maze to an image (with level-based color)
-func (r *Renderer) RenderMaze(maze *Maze, img *image.RGBA, frame int, level int) {
+// RenderMaze renders the entire maze to an image (with level-based color,
+// or white walls while flashing at the end of a level)
+func (r *Renderer) RenderMaze(maze *Maze, img *image.RGBA, frame int, level int, flash bool) {
 	// Power pellet blinks same speed as Pac-Man mouth (every 2 frames = 30 times per second)
 	showPowerPellet := (frame/2)%2 == 0
 
 	// Change maze color based on level
 	mazeColor := r.getMazeColor(level)
+	if flash {
+		mazeColor = ColorWhite
+	}
 
 	for y := 0; y < maze.Height; y++ {
 		for x := 0; x < maze.Width; x++ {
@@ -66,8 +71,21 @@ func (r *Renderer) RenderMaze(maze *Maze, img *image.RGBA, frame int, level int)
 	}
 }
 
-// RenderPacman renders Pac-Man with pixel art
-func (r *Renderer) RenderPacman(img *image.RGBA, x, y int, dir Direction, animFrame int) {
+// RenderPacman renders Pac-Man with pixel art, centered on pixel (px, py)
+func (r *Renderer) RenderPacman(img *image.RGBA, px, py int, dir Direction, animFrame int) {
+	x, y := r.spriteOrigin(px, py)
+	r.renderSprite(img, x, y, r.pacmanSprite(dir, animFrame), sprites.ColorPacmanYellow)
+}
+
+// RenderBigPacman renders the double-size Pac-Man of the first
+// intermission, centered on pixel (px, py)
+func (r *Renderer) RenderBigPacman(img *image.RGBA, px, py int, dir Direction, animFrame int) {
+	x, y := (px-TileSize)*r.scale, (py-TileSize)*r.scale
+	r.renderSprite(img, x, y, r.scaleUp(r.pacmanSprite(dir, animFrame), 2), sprites.ColorPacmanYellow)
+}
+
+// pacmanSprite picks Pac-Man's sprite for his direction and animation frame
+func (r *Renderer) pacmanSprite(dir Direction, animFrame int) [][]int {
 	// Determine which Pac-Man sprite to use based on animation frame
 	animCycle := (animFrame / AnimationSpeed) % 2
 	var pacmanSprite [][]int
@@ -89,16 +107,16 @@ func (r *Renderer) RenderPacman(img *image.RGBA, x, y int, dir Direction, animFr
 			pacmanSprite = sprites.PacmanOpenRight
 		}
 	}
-
-	// NO OFFSET - render exactly like test files
-	r.renderSprite(img, x*TileSize*r.scale, y*TileSize*r.scale, pacmanSprite, sprites.ColorPacmanYellow)
+	return pacmanSprite
 }
 
-// RenderGhost renders a ghost with pixel art
-func (r *Renderer) RenderGhost(img *image.RGBA, x, y int, bodyColor color.RGBA, frightened bool, blinking bool, isEyes bool, frame int) {
+// RenderGhost renders a ghost with pixel art, centered on pixel (px, py)
+func (r *Renderer) RenderGhost(img *image.RGBA, px, py int, bodyColor color.RGBA, frightened bool, blinking bool, isEyes bool, frame int) {
+	x, y := r.spriteOrigin(px, py)
+
 	// If eaten, show only eyes
 	if isEyes {
-		r.renderGhostEyes(img, x*TileSize*r.scale, y*TileSize*r.scale)
+		r.renderGhostEyes(img, x, y)
 		return
 	}
 
@@ -114,8 +132,54 @@ func (r *Renderer) RenderGhost(img *image.RGBA, x, y int, bodyColor color.RGBA,
 		bodyColor = ColorFrightened
 	}
 
-	// NO OFFSET - render exactly like test files
-	r.renderGhostSprite(img, x*TileSize*r.scale, y*TileSize*r.scale, bodyColor)
+	r.renderGhostSprite(img, x, y, bodyColor)
+}
+
+// spriteOrigin converts an entity's center pixel to the screen position of
+// the top-left corner of its one-tile sprite
+func (r *Renderer) spriteOrigin(px, py int) (int, int) {
+	return (px - TileSize/2) * r.scale, (py - TileSize/2) * r.scale
+}
+
+// RenderPoints draws a points value in the small maze font, centered on
+// pixel (px, py)
+func (r *Renderer) RenderPoints(img *image.RGBA, px, py int, points int, c color.RGBA) {
+	r.RenderText(img, px, py, strconv.Itoa(points), c)
+}
+
+// RenderText draws text in the small maze font, centered on pixel (px, py).
+// Characters missing from the font are left blank.
+func (r *Renderer) RenderText(img *image.RGBA, px, py int, text string, c color.RGBA) {
+	const glyphW, glyphH = 3, 5
+	width := len(text)*(glyphW+1) - 1
+	x0 := (px - width/2) * r.scale
+	y0 := (py - glyphH/2) * r.scale
+
+	for i, ch := range text {
+		gx := x0 + i*(glyphW+1)*r.scale
+		for y, row := range sprites.Font[ch] {
+			for x, lit := range row {
+				if lit == 0 {
+					continue
+				}
+				for dy := 0; dy < r.scale; dy++ {
+					for dx := 0; dx < r.scale; dx++ {
+						img.Set(gx+x*r.scale+dx, y0+y*r.scale+dy, c)
+					}
+				}
+			}
+		}
+	}
+}
+
+// RenderPacmanDeath draws frame of the death animation centered on pixel
+// (px, py). Frames past the end of the animation draw nothing.
+func (r *Renderer) RenderPacmanDeath(img *image.RGBA, px, py int, frame int) {
+	if frame < 0 || frame >= len(sprites.PacmanDeath) {
+		return
+	}
+	x, y := r.spriteOrigin(px, py)
+	r.renderSprite(img, x, y, sprites.PacmanDeath[frame], sprites.ColorPacmanYellow)
 }
 
 // RenderHUD renders score, lives, etc. (uses terminal output instead of image)
@@ -123,9 +187,28 @@ func (r *Renderer) RenderHUD(img *image.RGBA, score, lives, level int) {
 	// HUD is rendered using terminal text after the Sixel image
 }
 
-// RenderGameOver renders game over message (uses terminal output)
-func (r *Renderer) RenderGameOver(img *image.RGBA, won bool) {
-	// Game over is rendered using terminal text after the Sixel image
+// Center of the message area below the ghost house, where the arcade
+// shows READY! and GAME OVER
+const (
+	messageX = MazeWidthTiles * TileSize / 2
+	messageY = 17*TileSize + TileSize/2
+)
+
+// RenderReady draws the READY! banner below the ghost house
+func (r *Renderer) RenderReady(img *image.RGBA) {
+	r.RenderText(img, messageX, messageY, "READY!", ColorPacmanYellow)
+}
+
+// RenderPaused draws the PAUSED overlay in the middle of the maze
+func (r *Renderer) RenderPaused(img *image.RGBA) {
+	y := 14*TileSize + TileSize/2
+	r.fillRect(img, messageX-20, y-6, 40, 12, ColorBlack)
+	r.RenderText(img, messageX, y, "PAUSED", ColorWhite)
+}
+
+// RenderGameOver renders the game over message below the ghost house
+func (r *Renderer) RenderGameOver(img *image.RGBA) {
+	r.RenderText(img, messageX, messageY, "GAME OVER", ColorBlinkyRed)
 }
 
 // Helper function to render a sprite
@@ -177,6 +260,19 @@ func (r *Renderer) renderGhostSprite(img *image.RGBA, x, y int, bodyColor color.
 	}
 }
 
+// scaleUp returns sprite with every pixel repeated factor times each way
+func (r *Renderer) scaleUp(sprite [][]int, factor int) [][]int {
+	scaled := make([][]int, len(sprite)*factor)
+	for y := range scaled {
+		row := sprite[y/factor]
+		scaled[y] = make([]int, len(row)*factor)
+		for x := range scaled[y] {
+			scaled[y][x] = row[x/factor]
+		}
+	}
+	return scaled
+}
+
 // Sprite rotation helpers
 func (r *Renderer) flipHorizontal(sprite [][]int) [][]int {
 	height := len(sprite)
@@ -261,51 +357,83 @@ func (r *Renderer) renderGhostEyes(img *image.RGBA, x, y int) {
 	}
 }
 
-// Render bonus fruit with pixel art
+// Sprite for each bonus fruit
+var fruitSprites = map[FruitType]sprites.ColorSprite{
+	FruitCherry:     sprites.Cherry,
+	FruitStrawberry: sprites.Strawberry,
+	FruitOrange:     sprites.Orange,
+	FruitApple:      sprites.Apple,
+	FruitMelon:      sprites.Melon,
+	FruitGalaxian:   sprites.Galaxian,
+	FruitBell:       sprites.Bell,
+	FruitKey:        sprites.Key,
+}
+
+// Render bonus fruit with pixel art, centered on its tile
 func (r *Renderer) RenderFruit(img *image.RGBA, fruit *Fruit) {
 	if fruit == nil || !fruit.Active {
 		return
 	}
 
-	tileX := fruit.X * TileSize * r.scale
-	tileY := fruit.Y * TileSize * r.scale
-	fruitColor := fruit.GetColor()
-	stemColor := color.RGBA{139, 69, 19, 255} // Brown stem
-
-	// Simple cherry sprite (8x8 pixels)
-	// Pattern: two cherries with stems
-	cherryPattern := [][]int{
-		{0, 0, 1, 1, 1, 0, 0, 0}, // Stem
-		{0, 0, 0, 1, 0, 0, 0, 0},
-		{0, 2, 2, 0, 2, 2, 0, 0}, // Two cherries
-		{0, 2, 2, 2, 2, 2, 0, 0},
-		{0, 2, 2, 2, 2, 2, 0, 0},
-		{0, 0, 2, 2, 2, 0, 0, 0},
-		{0, 0, 0, 0, 0, 0, 0, 0},
-		{0, 0, 0, 0, 0, 0, 0, 0},
-	}
+	x, y := r.spriteOrigin(fruit.X*TileSize+TileSize/2, fruit.Y*TileSize+TileSize/2)
+	r.renderColorSprite(img, x, y, fruitSprites[fruit.Type])
+}
 
+// Helper function to render a sprite that carries its own palette
+func (r *Renderer) renderColorSprite(img *image.RGBA, x, y int, sprite sprites.ColorSprite) {
 	pixelSize := r.scale / 2
 	if pixelSize < 1 {
 		pixelSize = 1
 	}
 
-	for py := 0; py < 8; py++ {
-		for px := 0; px < 8; px++ {
-			var c color.RGBA
-			switch cherryPattern[py][px] {
-			case 1:
-				c = stemColor
-			case 2:
-				c = fruitColor
-			default:
+	for py := 0; py < len(sprite.Pixels); py++ {
+		for px := 0; px < len(sprite.Pixels[py]); px++ {
+			index := sprite.Pixels[py][px]
+			if index <= 0 || index >= len(sprite.Palette) {
 				continue
 			}
+			c := sprite.Palette[index]
 			for dy := 0; dy < pixelSize; dy++ {
 				for dx := 0; dx < pixelSize; dx++ {
-					img.Set(tileX+px*pixelSize+dx, tileY+py*pixelSize+dy, c)
+					img.Set(x+px*pixelSize+dx, y+py*pixelSize+dy, c)
 				}
 			}
 		}
 	}
 }
+
+// RenderCutscene draws the actors of a running intermission on a blank screen
+func (r *Renderer) RenderCutscene(img *image.RGBA, cutscene *Cutscene, frame int) {
+	for _, actor := range cutscene.Actors {
+		x, y := r.spriteOrigin(actor.PX, actor.PY)
+		switch actor.Look {
+		case LookPacman:
+			r.RenderPacman(img, actor.PX, actor.PY, actor.Dir, actor.AnimFrame)
+		case LookBigPacman:
+			r.RenderBigPacman(img, actor.PX, actor.PY, actor.Dir, actor.AnimFrame)
+		case LookBlinky:
+			r.RenderGhost(img, actor.PX, actor.PY, ColorBlinkyRed, false, false, false, frame)
+		case LookFrightened:
+			r.RenderGhost(img, actor.PX, actor.PY, ColorBlinkyRed, true, false, false, frame)
+		case LookBlinkyTorn:
+			r.renderColorSprite(img, x, y, sprites.GhostTorn)
+		case LookBlinkyPatched:
+			r.renderColorSprite(img, x, y, sprites.GhostPatched)
+		case LookBlinkyNaked:
+			r.renderColorSprite(img, x, y, sprites.GhostNaked)
+		case LookNail:
+			// A nail head with a short shaft
+			r.fillRect(img, actor.PX-1, actor.PY-2, 3, 1, ColorPellet)
+			r.fillRect(img, actor.PX, actor.PY-1, 1, 3, ColorPellet)
+		}
+	}
+}
+
+// fillRect fills a w by h rectangle at maze pixel (px, py)
+func (r *Renderer) fillRect(img *image.RGBA, px, py, w, h int, c color.RGBA) {
+	for y := py * r.scale; y < (py+h)*r.scale; y++ {
+		for x := px * r.scale; x < (px+w)*r.scale; x++ {
+			img.Set(x, y, c)
+		}
+	}
+}
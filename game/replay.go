@@ -0,0 +1,182 @@
+package game
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/eiannone/keyboard"
+)
+
+// Replay file layout (all integers are varints):
+//
+//	magic "PMRP" | format byte | version length + bytes | sim version |
+//	seed | rule flags | extra life score | event count | events...
+//
+// Each event stores the tick delta since the previous event, the key code
+// and the rune, which keeps a typical game well under a few kilobytes.
+const (
+	replayMagic  = "PMRP"
+	replayFormat = 1
+)
+
+// ReplayEvent is a key event stamped with the tick it was applied on.
+type ReplayEvent struct {
+	Tick  int
+	Event keyboard.KeyEvent
+}
+
+// Replay is a recorded game: the seed plus every key event that reached
+// the game, so the run can be reproduced exactly.
+type Replay struct {
+	Version    string // Game version that recorded the run
+	SimVersion int    // SimVersion of the build that recorded the run
+	Seed       int64
+	Rules      Rules
+	Events     []ReplayEvent
+}
+
+// Rule flag bits stored in replay files
+const (
+	replayRuleUpOverflowBug = 1 << iota
+	replayRuleExtraLifeEvery
+)
+
+// Record appends ev, applied on tick.
+func (r *Replay) Record(tick int, ev keyboard.KeyEvent) {
+	r.Events = append(r.Events, ReplayEvent{Tick: tick, Event: ev})
+}
+
+// WriteTo encodes the replay to w.
+func (r *Replay) WriteTo(w io.Writer) (int64, error) {
+	var buf []byte
+	buf = append(buf, replayMagic...)
+	buf = append(buf, replayFormat)
+	buf = binary.AppendUvarint(buf, uint64(len(r.Version)))
+	buf = append(buf, r.Version...)
+	buf = binary.AppendUvarint(buf, uint64(r.SimVersion))
+	buf = binary.AppendVarint(buf, r.Seed)
+	var flags uint64
+	if r.Rules.UpOverflowBug {
+		flags |= replayRuleUpOverflowBug
+	}
+	if r.Rules.ExtraLife.Every {
+		flags |= replayRuleExtraLifeEvery
+	}
+	buf = binary.AppendUvarint(buf, flags)
+	buf = binary.AppendUvarint(buf, uint64(r.Rules.ExtraLife.Score))
+	buf = binary.AppendUvarint(buf, uint64(len(r.Events)))
+
+	lastTick := 0
+	for _, e := range r.Events {
+		buf = binary.AppendUvarint(buf, uint64(e.Tick-lastTick))
+		buf = binary.AppendUvarint(buf, uint64(e.Event.Key))
+		buf = binary.AppendUvarint(buf, uint64(e.Event.Rune))
+		lastTick = e.Tick
+	}
+
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// ReadReplay decodes a replay written by WriteTo.
+func ReadReplay(r io.Reader) (*Replay, error) {
+	br := bufio.NewReader(r)
+
+	header := make([]byte, len(replayMagic)+1)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, fmt.Errorf("reading replay header: %w", err)
+	}
+	if string(header[:len(replayMagic)]) != replayMagic {
+		return nil, errors.New("not a replay file")
+	}
+	format := header[len(replayMagic)]
+	if format != replayFormat {
+		return nil, fmt.Errorf("unsupported replay format %d", format)
+	}
+
+	versionLen, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, fmt.Errorf("reading replay version: %w", err)
+	}
+	if versionLen > 256 {
+		return nil, errors.New("replay version string too long")
+	}
+	version := make([]byte, versionLen)
+	if _, err := io.ReadFull(br, version); err != nil {
+		return nil, fmt.Errorf("reading replay version: %w", err)
+	}
+
+	simVersion, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, fmt.Errorf("reading replay sim version: %w", err)
+	}
+
+	seed, err := binary.ReadVarint(br)
+	if err != nil {
+		return nil, fmt.Errorf("reading replay seed: %w", err)
+	}
+
+	flags, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, fmt.Errorf("reading replay rules: %w", err)
+	}
+	extraLife, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, fmt.Errorf("reading replay rules: %w", err)
+	}
+
+	count, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, fmt.Errorf("reading replay event count: %w", err)
+	}
+
+	rep := &Replay{Version: string(version), SimVersion: int(simVersion), Seed: seed}
+	rep.Rules.UpOverflowBug = flags&replayRuleUpOverflowBug != 0
+	rep.Rules.ExtraLife = ExtraLife{
+		Score: int(extraLife),
+		Every: flags&replayRuleExtraLifeEvery != 0,
+	}
+	tick := 0
+	for i := uint64(0); i < count; i++ {
+		var fields [3]uint64
+		for j := range fields {
+			if fields[j], err = binary.ReadUvarint(br); err != nil {
+				return nil, fmt.Errorf("reading replay event %d: %w", i, err)
+			}
+		}
+		tick += int(fields[0])
+		rep.Record(tick, keyboard.KeyEvent{
+			Key:  keyboard.Key(fields[1]),
+			Rune: rune(fields[2]),
+		})
+	}
+
+	return rep, nil
+}
+
+// LoadReplay reads a replay file from disk.
+func LoadReplay(path string) (*Replay, error) {
+	f, err := os.Open(path) // #nosec G304 -- path is chosen by the player
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadReplay(f)
+}
+
+// Save writes the replay to path, replacing any existing file.
+func (r *Replay) Save(path string) error {
+	f, err := os.Create(path) // #nosec G304 -- path is chosen by the player
+	if err != nil {
+		return err
+	}
+	if _, err := r.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
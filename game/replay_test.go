@@ -0,0 +1,33 @@
+package game
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/eiannone/keyboard"
+)
+
+func TestReplayRoundTrip(t *testing.T) {
+	want := &Replay{
+		Version:    "1.2.3",
+		SimVersion: SimVersion,
+		Seed:       -42,
+		Rules:      Rules{UpOverflowBug: true, ExtraLife: ExtraLife{Score: 5000, Every: true}},
+	}
+	want.Record(0, keyboard.KeyEvent{Key: keyboard.KeySpace})
+	want.Record(90, keyboard.KeyEvent{Key: keyboard.KeyArrowLeft})
+	want.Record(95, keyboard.KeyEvent{Rune: 'q'})
+
+	var buf bytes.Buffer
+	if _, err := want.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadReplay(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+package game
+
+// RNG is the game's deterministic pseudo-random source (SplitMix64).
+// Everything in the game that picks randomly must draw from the Sim's RNG,
+// so that two runs with the same seed and the same inputs stay identical.
+type RNG struct {
+	state uint64
+}
+
+func NewRNG(seed int64) *RNG {
+	return &RNG{state: uint64(seed)}
+}
+
+// Uint64 returns the next pseudo-random 64-bit value.
+func (r *RNG) Uint64() uint64 {
+	r.state += 0x9e3779b97f4a7c15
+	z := r.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// Intn returns a pseudo-random number in [0, n). It panics if n <= 0.
+func (r *RNG) Intn(n int) int {
+	if n <= 0 {
+		panic("game: RNG.Intn called with n <= 0")
+	}
+	return int(r.Uint64() % uint64(n))
+}
+
+// State returns the generator's internal state, for snapshots and debugging.
+func (r *RNG) State() uint64 {
+	return r.state
+}
@@ -0,0 +1,57 @@
+package game
+
+// ghostEatenFreeze is how long play stops to show the points for an eaten ghost
+const ghostEatenFreeze = TicksPerSecond
+
+// ScoreChain tracks ghosts eaten on power pellets for the current level.
+type ScoreChain struct {
+	Eaten   int // Ghosts eaten on the current power pellet
+	Perfect int // Power pellets this level on which all four ghosts were eaten
+}
+
+// Popup is a points value shown where something was eaten while play is
+// frozen. PX, PY is the center pixel of the capture tile.
+type Popup struct {
+	PX, PY int
+	Points int
+	Ghost  GhostType // Ghost hidden while its points are shown
+	Ticks  int       // Ticks left before play resumes
+}
+
+// ghostPoints returns the score for the nth ghost eaten on one power
+// pellet: 200, 400, 800, then 1600.
+func ghostPoints(n int) int {
+	if n > 4 {
+		n = 4
+	}
+	return GhostScore << (n - 1)
+}
+
+// onEnergizer starts a new ghost chain when Pac-Man eats a power pellet.
+func (s *Sim) onEnergizer() {
+	s.chain.Eaten = 0
+}
+
+// eatGhost scores ghost, shows its points at the capture tile and freezes
+// play. Eating all four ghosts on every power pellet of a level also
+// awards AllGhostsBonus.
+func (s *Sim) eatGhost(ghost *Ghost) {
+	s.chain.Eaten++
+	points := ghostPoints(s.chain.Eaten)
+	s.score += points
+
+	if s.chain.Eaten == len(s.ghosts) {
+		s.chain.Perfect++
+		if s.chain.Perfect == s.maze.TotalPowerPellets {
+			s.score += AllGhostsBonus
+		}
+	}
+
+	s.popup = &Popup{
+		PX:     ghost.X*TileSize + TileSize/2,
+		PY:     ghost.Y*TileSize + TileSize/2,
+		Points: points,
+		Ghost:  ghost.Type,
+		Ticks:  ghostEatenFreeze,
+	}
+}
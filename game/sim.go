@@ -0,0 +1,408 @@
+package game
+
+// Input is the player's intent for a single simulation tick.
+// The zero value means "no input".
+type Input struct {
+	Dir   Direction // Requested Pac-Man direction (DirNone keeps the current one)
+	Start bool      // Start a game from the start screen
+	Retry bool      // Restart after game over
+	Skip  bool      // Skip an intermission
+}
+
+// Rules are gameplay settings fixed for the whole run.
+type Rules struct {
+	UpOverflowBug bool      // Reproduce the arcade bug in Pinky's and Inky's "ahead of Pac-Man" targets
+	ExtraLife     ExtraLife // When bonus lives are awarded
+}
+
+// SimVersion numbers the Sim's step behaviour. Bump it whenever a change
+// makes the same seed and inputs play out differently, so replays recorded
+// before the change are refused rather than silently desyncing.
+const SimVersion = 2
+
+// Sim is the headless game world. It owns all gameplay state and advances
+// one tick at a time from an Input, without touching the terminal.
+type Sim struct {
+	maze           *Maze
+	pacman         *Pacman
+	ghosts         []*Ghost
+	state          GameState
+	stateTicks     int // Ticks spent in the current state
+	score          int
+	lives          int
+	level          int
+	frame          int
+	fruit          *Fruit
+	seed           int64
+	rng            *RNG
+	rules          Rules
+	schedule       ModeSchedule
+	house          HouseRelease
+	elroySuspended bool // Cruise Elroy is off after a death until Clyde leaves the house
+	chain          ScoreChain
+	popup          *Popup // Points for the last ghost eaten, while play is frozen
+	livesAwarded   int    // Bonus lives given so far this game
+	cutscene       *Cutscene
+}
+
+// Snapshot is an immutable copy of the world taken between ticks.
+// Mutating it never affects the Sim it came from. It is plain data, so two
+// Sims with the same seed and inputs encode (e.g. with encoding/json) to
+// byte-identical snapshots after the same number of ticks.
+type Snapshot struct {
+	State          GameState
+	StateTicks     int
+	Score          int
+	Lives          int
+	Level          int
+	Frame          int
+	Seed           int64
+	RandState      uint64
+	Schedule       ModeSchedule
+	House          HouseRelease
+	ElroySuspended bool
+	Maze           *Maze
+	Pacman         Pacman
+	Ghosts         []Ghost
+	Fruit          *Fruit // nil when no fruit is on screen
+	Chain          ScoreChain
+	Popup          *Popup    // nil unless play is frozen on an eaten ghost
+	NextExtraLife  int       // Score of the next bonus life; 0 if none are left
+	Cutscene       *Cutscene // nil unless an intermission is playing
+}
+
+// NewSim creates a world on the start screen. All randomness is drawn
+// from an RNG seeded with seed, so equal seeds and rules give reproducible runs.
+func NewSim(seed int64, rules Rules) *Sim {
+	s := &Sim{
+		maze:   NewMaze(),
+		pacman: NewPacman(),
+		state:  StateStart,
+		lives:  InitialLives,
+		level:  1,
+		seed:   seed,
+		rng:    NewRNG(seed),
+		rules:  rules,
+	}
+
+	// Blinky starts above the door, the others inside the ghost house
+	s.ghosts = []*Ghost{
+		NewGhost(GhostBlinky),
+		NewGhost(GhostPinky),
+		NewGhost(GhostInky),
+		NewGhost(GhostClyde),
+	}
+
+	return s
+}
+
+// Step applies one tick of input and advances the world by one tick.
+func (s *Sim) Step(in Input) {
+	s.applyInput(in)
+	s.update()
+	s.frame++
+}
+
+// State returns the current game state.
+func (s *Sim) State() GameState {
+	return s.state
+}
+
+// Frame returns the number of ticks simulated so far.
+func (s *Sim) Frame() int {
+	return s.frame
+}
+
+// Seed returns the seed the Sim's RNG was created with.
+func (s *Sim) Seed() int64 {
+	return s.seed
+}
+
+// Snapshot returns a deep copy of the current world.
+func (s *Sim) Snapshot() Snapshot {
+	snap := Snapshot{
+		State:          s.state,
+		StateTicks:     s.stateTicks,
+		Score:          s.score,
+		Lives:          s.lives,
+		Level:          s.level,
+		Frame:          s.frame,
+		Seed:           s.seed,
+		RandState:      s.rng.State(),
+		Schedule:       s.schedule,
+		House:          s.house,
+		ElroySuspended: s.elroySuspended,
+		Chain:          s.chain,
+		NextExtraLife:  s.rules.ExtraLife.Next(s.livesAwarded),
+		Maze:           s.maze.Clone(),
+		Pacman:         *s.pacman,
+		Ghosts:         make([]Ghost, len(s.ghosts)),
+	}
+	for i, ghost := range s.ghosts {
+		snap.Ghosts[i] = *ghost
+	}
+	if s.fruit != nil {
+		fruit := *s.fruit
+		snap.Fruit = &fruit
+	}
+	if s.popup != nil {
+		popup := *s.popup
+		snap.Popup = &popup
+	}
+	if s.cutscene != nil {
+		snap.Cutscene = s.cutscene.Clone()
+	}
+	return snap
+}
+
+func (s *Sim) applyInput(in Input) {
+	switch s.state {
+	case StateStart:
+		if in.Start {
+			s.setState(StateReady)
+		}
+	case StateGameOver:
+		if in.Retry {
+			s.Reset()
+		}
+	case StatePlaying, StateReady:
+		if in.Dir != DirNone {
+			s.pacman.SetDirection(in.Dir)
+		}
+	case StateIntermission:
+		if in.Skip {
+			s.endIntermission()
+		}
+	}
+}
+
+func (s *Sim) update() {
+	switch s.state {
+	case StatePlaying:
+		// Handled below
+	case StateDying:
+		s.updateDying()
+		return
+	case StateReady:
+		s.updateReady()
+		return
+	case StateLevelClear:
+		s.updateLevelClear()
+		return
+	case StateIntermission:
+		s.updateIntermission()
+		return
+	case StateGameOver:
+		s.updateGameOver()
+		return
+	default:
+		return
+	}
+
+	// Everything stands still while an eaten ghost's points are shown
+	if s.popup != nil {
+		s.popup.Ticks--
+		if s.popup.Ticks <= 0 {
+			s.popup = nil
+		}
+		return
+	}
+
+	// Despawn fruit once its time is up
+	if s.fruit != nil && s.fruit.Active && !s.fruit.Eaten {
+		s.fruit.SpawnTime++
+		if s.fruit.SpawnTime >= s.fruit.Duration {
+			s.fruit = nil
+		}
+	}
+
+	// Update Pacman
+	prevTileX, prevTileY := s.pacman.X, s.pacman.Y
+	s.pacman.Update(s.maze, s.pacmanSpeed())
+
+	// Check pellet eating. Pac-Man is slowed for the whole tile a pellet was on.
+	isPower, ate := s.maze.EatPellet(s.pacman.X, s.pacman.Y)
+	if s.pacman.X != prevTileX || s.pacman.Y != prevTileY {
+		s.pacman.Eating = ate
+	}
+	if ate {
+		s.onPelletEaten()
+		s.spawnFruit()
+		if isPower {
+			s.score += PowerPelletScore
+			s.onEnergizer()
+			s.pacman.ActivatePowerMode(s.level)
+			for _, ghost := range s.ghosts {
+				if s.pacman.PowerMode {
+					ghost.Frighten()
+				} else if ghost.Mode != ModeEaten {
+					// No fright time this level: the ghosts just turn around
+					ghost.Reverse = ghost.House == HouseOutside
+				}
+			}
+		} else {
+			s.score += PelletScore
+		}
+	}
+
+	// Check fruit eating
+	if s.fruit != nil && s.fruit.Active && !s.fruit.Eaten {
+		if s.pacman.X == s.fruit.X && s.pacman.Y == s.fruit.Y {
+			s.score += FruitScores[s.fruit.Type]
+			s.fruit.Eaten = true
+			s.fruit = nil
+		}
+	}
+
+	// Advance the scatter/chase schedule (paused while ghosts are frightened)
+	// and make every ghost turn around when it switches phase
+	if !s.pacman.PowerMode && s.schedule.Tick(s.level) {
+		for _, ghost := range s.ghosts {
+			if ghost.Mode == ModeScatter || ghost.Mode == ModeChase {
+				ghost.Mode = s.schedule.Mode()
+				ghost.Reverse = ghost.House == HouseOutside
+			}
+		}
+	}
+
+	// Release ghosts from the house
+	s.updateHouse()
+
+	// Speed Blinky up as the maze empties
+	s.updateElroy()
+
+	// Update ghosts (pass level for difficulty scaling). Like the arcade's,
+	// they pass through each other freely.
+	env := &GhostEnv{
+		Maze:   s.maze,
+		Pacman: s.pacman,
+		Ghosts: s.ghosts,
+		Level:  s.level,
+		Mode:   s.schedule.Mode(),
+		RNG:    s.rng,
+		Rules:  s.rules,
+	}
+	for _, ghost := range s.ghosts {
+		ghost.Update(env)
+	}
+
+	// Check collisions
+	s.checkCollisions()
+
+	// Award bonus lives
+	s.checkExtraLife()
+
+	// Check win (level complete)
+	if s.state == StatePlaying && s.maze.RemainingPellets == 0 {
+		s.clearLevel()
+	}
+}
+
+func (s *Sim) NextLevel() {
+	s.level++
+	s.maze.Reset()
+	s.resetActors()
+	s.resetHouse()
+	s.elroySuspended = false
+	s.chain = ScoreChain{}
+	s.popup = nil
+	s.setState(StateReady)
+}
+
+func (s *Sim) Reset() {
+	s.score = 0
+	s.lives = InitialLives
+	s.livesAwarded = 0
+	s.level = 1
+	s.maze.Reset()
+	s.resetActors()
+	s.resetHouse()
+	s.elroySuspended = false
+	s.chain = ScoreChain{}
+	s.popup = nil
+	s.setState(StateReady)
+}
+
+// ResetToTitle starts a new game like Reset, but waits on the start
+// screen for the player.
+func (s *Sim) ResetToTitle() {
+	s.Reset()
+	s.setState(StateStart)
+}
+
+// spawnFruit puts the level's fruit out when the pellets eaten this level
+// reach one of fruitPelletCounts.
+func (s *Sim) spawnFruit() {
+	eaten := s.maze.TotalPellets - s.maze.RemainingPellets
+	for _, count := range fruitPelletCounts {
+		if eaten == count {
+			s.fruit = NewFruit(s.level, s.rng)
+		}
+	}
+}
+
+// resetActors puts Pac-Man and the ghosts back at their start positions
+// and restarts the scatter/chase schedule.
+func (s *Sim) resetActors() {
+	s.pacman.SetTile(14, 23)
+	s.pacman.SpeedCredit = 0
+	s.pacman.Dir = DirNone
+	s.pacman.NextDir = DirNone
+	s.pacman.PowerMode = false
+
+	for _, ghost := range s.ghosts {
+		ghost.ResetToStart()
+	}
+	s.schedule.Reset()
+	s.fruit = nil
+}
+
+// resetHouse clears every ghost-house counter for a fresh level.
+func (s *Sim) resetHouse() {
+	s.house = HouseRelease{}
+	for _, ghost := range s.ghosts {
+		ghost.DotCounter = 0
+	}
+}
+
+// pacmanSpeed returns Pac-Man's speed this tick, in percent of full speed.
+func (s *Sim) pacmanSpeed() int {
+	speeds := speedsForLevel(s.level)
+	switch {
+	case s.pacman.PowerMode && s.pacman.Eating:
+		return speeds.PacmanFrightDots
+	case s.pacman.PowerMode:
+		return speeds.PacmanFright
+	case s.pacman.Eating:
+		return speeds.PacmanDots
+	}
+	return speeds.Pacman
+}
+
+// collisionDistance is how close (in pixels, on each axis) a ghost must get
+// to Pac-Man to touch him. Closing speeds stay well below twice this, so
+// the two can't pass through each other between ticks.
+const collisionDistance = 6
+
+func (s *Sim) checkCollisions() {
+	for _, ghost := range s.ghosts {
+		dx := s.pacman.PX - ghost.PX
+		dy := s.pacman.PY - ghost.PY
+		collision := dx > -collisionDistance && dx < collisionDistance &&
+			dy > -collisionDistance && dy < collisionDistance
+
+		if collision {
+			if s.pacman.PowerMode && ghost.Mode == ModeFrightened {
+				// Eat ghost - becomes eyes and returns to ghost house ENTRANCE
+				s.eatGhost(ghost)
+				ghost.Mode = ModeEaten
+				ghost.TargetX = houseDoorX
+				ghost.TargetY = houseEntryY // Target entrance above ghost house, not inside
+			} else if ghost.Mode != ModeFrightened && ghost.Mode != ModeEaten {
+				// Pac-Man dies
+				s.killPacman()
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package game
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"testing"
+)
+
+// scriptedRun plays ticks of a game from seed, steered by the autopilot
+// with a fixed turn every 97 ticks, and returns the encoded final snapshot.
+func scriptedRun(t *testing.T, seed int64, ticks int) []byte {
+	t.Helper()
+	sim := NewSim(seed, Rules{ExtraLife: ExtraLife{Score: 10000}})
+	sim.Step(Input{Start: true})
+	turns := []Direction{DirUp, DirLeft, DirDown, DirRight}
+	for tick := 0; tick < ticks; tick++ {
+		in := Input{Dir: sim.AutopilotDir()}
+		if tick%97 == 0 {
+			in.Dir = turns[tick/97%len(turns)]
+		}
+		if sim.State() == StateGameOver {
+			in.Retry = true
+		}
+		sim.Step(in)
+	}
+	data, err := json.Marshal(sim.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// TestSameSeedSameState checks two Sims with the same seed and inputs end
+// in byte-identical states, which replays and the other tests rely on.
+func TestSameSeedSameState(t *testing.T) {
+	const ticks = 5000
+
+	first := scriptedRun(t, 7, ticks)
+	second := scriptedRun(t, 7, ticks)
+	if !bytes.Equal(first, second) {
+		t.Fatalf("same seed and inputs gave different states after %d ticks:\n%s\n%s", ticks, first, second)
+	}
+
+	if other := scriptedRun(t, 8, ticks); bytes.Equal(first, other) {
+		t.Errorf("seeds 7 and 8 gave identical states after %d ticks", ticks)
+	}
+}
+
+// TestGhostsStayOnWalkableTiles plays a long seeded game, steered by the
+// autopilot with random inputs mixed in, and checks no roaming ghost ever
+// ends a tick inside a wall.
+func TestGhostsStayOnWalkableTiles(t *testing.T) {
+	const ticks = 30000
+
+	sim := NewSim(3, Rules{ExtraLife: ExtraLife{Score: 10000}})
+	inputs := rand.New(rand.NewSource(3))
+	sim.Step(Input{Start: true})
+
+	for tick := 0; tick < ticks; tick++ {
+		in := Input{Dir: sim.AutopilotDir()}
+		if inputs.Intn(8) == 0 {
+			in.Dir = []Direction{DirUp, DirDown, DirLeft, DirRight}[inputs.Intn(4)]
+		}
+		switch sim.State() {
+		case StateGameOver:
+			in.Retry = true
+		case StateIntermission:
+			in.Skip = true
+		}
+		sim.Step(in)
+
+		for _, ghost := range sim.ghosts {
+			if ghost.House != HouseOutside {
+				continue
+			}
+			if !sim.maze.IsWalkable(ghost.X, ghost.Y) {
+				t.Fatalf("tick %d: ghost %d at pixel (%d,%d) heading %v is inside tile (%d,%d)",
+					sim.Frame(), ghost.Type, ghost.PX, ghost.PY, ghost.Dir, ghost.X, ghost.Y)
+			}
+		}
+	}
+}
+
+// TestGameOverReturnsToTitle checks an unanswered GAME OVER goes back to
+// the start screen, so attract mode can run again.
+func TestGameOverReturnsToTitle(t *testing.T) {
+	sim := NewSim(1, Rules{})
+	sim.Step(Input{Start: true})
+	sim.score = 1230
+	sim.setState(StateGameOver)
+
+	for tick := 0; tick < gameOverTitleTicks; tick++ {
+		if sim.State() != StateGameOver {
+			t.Fatalf("left GAME OVER after %d ticks, want %d", tick, gameOverTitleTicks)
+		}
+		sim.Step(Input{})
+	}
+	if sim.State() != StateStart {
+		t.Fatalf("state is %v after GAME OVER timed out, want StateStart", sim.State())
+	}
+	if sim.score != 0 {
+		t.Errorf("score is %d on the title, want a fresh game", sim.score)
+	}
+}
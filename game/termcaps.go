@@ -0,0 +1,161 @@
+package game
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// TermCaps is what the terminal says it can do, as far as the displays
+// care. Zero values mean the terminal didn't say.
+type TermCaps struct {
+	Asked          bool // The queries reached the terminal
+	Answered       bool // The terminal replied at all
+	Sixel          bool // Sixel graphics, from the primary device attributes
+	Kitty          bool // Kitty graphics protocol
+	Cols, Rows     int  // Size in character cells
+	CellWidth      int  // Pixels per character cell, across
+	CellHeight     int  // Pixels per character cell, down
+	ColorRegisters int  // Sixel colour registers
+}
+
+// capsTimeout bounds how long startup waits for the terminal's replies.
+// It is only reached on terminals that ignore the queries altogether.
+const capsTimeout = 250 * time.Millisecond
+
+// kittyQueryID is the image ID used to ask for kitty graphics support
+const kittyQueryID = 31
+
+// Queries sent to the terminal. The device attributes query goes last:
+// every terminal answers it, and in order, so its reply means all the
+// others have either arrived or been ignored.
+var capsQueries = "" +
+	"\033_Gi=" + strconv.Itoa(kittyQueryID) + ",s=1,v=1,a=q,t=d,f=24;AAAA\033\\" + // Kitty graphics: query a 1x1 image
+	"\033[16t" + // Cell size in pixels
+	"\033[14t" + // Text area size in pixels
+	"\033[?1;1;0S" + // XTSMGRAPHICS: number of colour registers
+	"\033[c" // Primary device attributes
+
+var (
+	replyDeviceAttrs = regexp.MustCompile(`\033\[\?([0-9;]*)c`)
+	replyKitty       = regexp.MustCompile(`\033_Gi=` + strconv.Itoa(kittyQueryID) + `;OK\033\\`)
+	replyCellSize    = regexp.MustCompile(`\033\[6;([0-9]+);([0-9]+)t`)
+	replyTextArea    = regexp.MustCompile(`\033\[4;([0-9]+);([0-9]+)t`)
+	replyRegisters   = regexp.MustCompile(`\033\[\?1;0;([0-9]+)S`)
+)
+
+// DetectTermCaps asks the controlling terminal about its graphics support.
+// It must run before the keyboard is opened, which would swallow the
+// replies. With no terminal, or one that can't time out a read, it leaves
+// Asked false; an error means the terminal's mode couldn't be switched or
+// put back.
+func DetectTermCaps() (caps TermCaps, err error) {
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return caps, nil // No terminal to ask
+	}
+	defer tty.Close()
+
+	// Don't use tty.Fd(), which would turn off the deadlines the reads
+	// rely on
+	conn, err := tty.SyscallConn()
+	if err != nil {
+		return caps, err
+	}
+	var oldState *term.State
+	var rawErr error
+	if err := conn.Control(func(fd uintptr) {
+		caps.Cols, caps.Rows, _ = term.GetSize(int(fd)) // Left at 0 if unknown
+		oldState, rawErr = term.MakeRaw(int(fd))
+	}); err != nil {
+		return caps, err
+	}
+	if rawErr != nil {
+		return caps, fmt.Errorf("switching terminal to raw mode: %w", rawErr)
+	}
+	defer func() {
+		var restoreErr error
+		if err := conn.Control(func(fd uintptr) {
+			restoreErr = term.Restore(int(fd), oldState)
+		}); err != nil {
+			restoreErr = err
+		}
+		if restoreErr != nil && err == nil {
+			err = fmt.Errorf("restoring terminal mode: %w", restoreErr)
+		}
+	}()
+
+	// Without a deadline a silent terminal would hang the game
+	if err := tty.SetReadDeadline(time.Now().Add(capsTimeout)); err != nil {
+		return caps, nil
+	}
+	if _, err := tty.WriteString(capsQueries); err != nil {
+		return caps, err
+	}
+	caps.Asked = true
+
+	var replies []byte
+	buf := make([]byte, 256)
+	for !replyDeviceAttrs.Match(replies) {
+		n, err := tty.Read(buf)
+		replies = append(replies, buf[:n]...)
+		if err != nil {
+			break // Timed out
+		}
+	}
+
+	caps.parseReplies(replies)
+	return caps, nil
+}
+
+// parseReplies fills in caps from the terminal's replies to capsQueries.
+func (caps *TermCaps) parseReplies(replies []byte) {
+	if m := replyDeviceAttrs.FindSubmatch(replies); m != nil {
+		caps.Answered = true
+		for _, attr := range bytes.Split(m[1], []byte(";")) {
+			if string(attr) == "4" {
+				caps.Sixel = true
+			}
+		}
+	}
+
+	caps.Kitty = replyKitty.Match(replies)
+
+	if m := replyCellSize.FindSubmatch(replies); m != nil {
+		caps.CellHeight, _ = strconv.Atoi(string(m[1]))
+		caps.CellWidth, _ = strconv.Atoi(string(m[2]))
+	} else if m := replyTextArea.FindSubmatch(replies); m != nil && caps.Cols > 0 && caps.Rows > 0 {
+		// Older terminals only report the whole text area
+		height, _ := strconv.Atoi(string(m[1]))
+		width, _ := strconv.Atoi(string(m[2]))
+		caps.CellWidth, caps.CellHeight = width/caps.Cols, height/caps.Rows
+	}
+
+	if m := replyRegisters.FindSubmatch(replies); m != nil {
+		caps.ColorRegisters, _ = strconv.Atoi(string(m[1]))
+	}
+}
+
+// BestDisplay names the display that looks best on the terminal: an image
+// protocol if there is one, colour text if the terminal at least answers,
+// and plain ASCII if it ignored the queries. A terminal that couldn't be
+// asked gets Sixel, the default from before terminals were asked.
+func (caps TermCaps) BestDisplay() string {
+	switch {
+	case caps.Kitty:
+		return "kitty"
+	case caps.Sixel:
+		return "sixel"
+	case caps.Answered:
+		return "halfblock"
+	case caps.Asked:
+		return "ascii"
+	default:
+		return "sixel"
+	}
+}
@@ -0,0 +1,58 @@
+package game
+
+import "testing"
+
+func TestParseReplies(t *testing.T) {
+	tests := []struct {
+		name    string
+		replies string
+		want    TermCaps
+		display string
+	}{
+		{
+			name:    "kitty and sixel",
+			replies: "\033_Gi=31;OK\033\\\033[6;20;10t\033[4;1000;800t\033[?1;0;1024S\033[?62;4;22c",
+			want:    TermCaps{Asked: true, Answered: true, Sixel: true, Kitty: true, Cols: 80, Rows: 50, CellWidth: 10, CellHeight: 20, ColorRegisters: 1024},
+			display: "kitty",
+		},
+		{
+			name:    "sixel with text area size only",
+			replies: "\033[4;800;640t\033[?64;4c",
+			want:    TermCaps{Asked: true, Answered: true, Sixel: true, Cols: 80, Rows: 50, CellWidth: 8, CellHeight: 16},
+			display: "sixel",
+		},
+		{
+			name:    "no graphics",
+			replies: "\033[?62;22c",
+			want:    TermCaps{Asked: true, Answered: true, Cols: 80, Rows: 50},
+			display: "halfblock",
+		},
+		{
+			name:    "no answer",
+			want:    TermCaps{Asked: true, Cols: 80, Rows: 50},
+			display: "ascii",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caps := TermCaps{Asked: true, Cols: 80, Rows: 50}
+			caps.parseReplies([]byte(tt.replies))
+			if caps != tt.want {
+				t.Errorf("got %+v, want %+v", caps, tt.want)
+			}
+			if got := caps.BestDisplay(); got != tt.display {
+				t.Errorf("BestDisplay() = %q, want %q", got, tt.display)
+			}
+		})
+	}
+}
+
+// TestBestDisplayUnasked checks a terminal that couldn't be asked keeps the
+// Sixel default rather than dropping to ASCII like one that ignored the
+// queries.
+func TestBestDisplayUnasked(t *testing.T) {
+	if got := (TermCaps{}).BestDisplay(); got != "sixel" {
+		t.Errorf("BestDisplay() with no queries sent = %q, want %q", got, "sixel")
+	}
+}
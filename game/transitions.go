@@ -0,0 +1,112 @@
+package game
+
+import "pacman/sprites"
+
+// Lengths of the timed states, in ticks
+const (
+	deathFreezeTicks = TicksPerSecond            // Everything stops after Pac-Man is caught
+	deathFrameTicks  = 4                         // Each frame of the death animation
+	deathFrames      = sprites.PacmanDeathFrames // Frames in the death animation
+	deathPauseTicks  = TicksPerSecond / 2        // Empty maze after the animation
+	readyTicks       = 2 * TicksPerSecond        // READY! before play (re)starts
+
+	levelClearFreezeTicks = TicksPerSecond // Everything stops after the last pellet
+	mazeFlashTicks        = 6              // Walls stay white or blue this long
+	mazeFlashes           = 4              // White flashes before the next level
+
+	gameOverTitleTicks = 10 * TicksPerSecond // GAME OVER left unanswered before returning to the title
+
+	deathTicks      = deathFreezeTicks + deathFrames*deathFrameTicks + deathPauseTicks
+	levelClearTicks = levelClearFreezeTicks + 2*mazeFlashes*mazeFlashTicks
+)
+
+// setState switches to state and restarts its tick counter.
+func (s *Sim) setState(state GameState) {
+	s.state = state
+	s.stateTicks = 0
+}
+
+// updateGameOver waits for a retry, and gives up and goes back to the
+// title, where attract mode runs again, if none comes.
+func (s *Sim) updateGameOver() {
+	s.stateTicks++
+	if s.stateTicks >= gameOverTitleTicks {
+		s.ResetToTitle()
+	}
+}
+
+// DeathFrame returns the frame of the death animation to show stateTicks
+// into StateDying, or -1 while the game is still frozen before it starts.
+func DeathFrame(stateTicks int) int {
+	if stateTicks < deathFreezeTicks {
+		return -1
+	}
+	return (stateTicks - deathFreezeTicks) / deathFrameTicks
+}
+
+// killPacman starts the death sequence after a ghost catches Pac-Man.
+func (s *Sim) killPacman() {
+	s.lives--
+	s.fruit = nil
+	s.popup = nil
+	// Ghosts now leave the house on the global pellet counter
+	s.house.GlobalActive = true
+	s.house.GlobalDots = 0
+	s.elroySuspended = true
+	s.setState(StateDying)
+}
+
+// updateDying runs the death sequence: a freeze, the death animation and
+// a pause, then either GAME OVER or the actors back at the start behind
+// a READY! pause.
+func (s *Sim) updateDying() {
+	s.stateTicks++
+	if s.stateTicks < deathTicks {
+		return
+	}
+
+	if s.lives <= 0 {
+		s.setState(StateGameOver)
+		return
+	}
+	s.resetActors()
+	s.setState(StateReady)
+}
+
+// updateReady holds everything still until the READY! pause is over.
+func (s *Sim) updateReady() {
+	s.stateTicks++
+	if s.stateTicks >= readyTicks {
+		s.setState(StatePlaying)
+	}
+}
+
+// MazeFlashWhite reports whether the walls are drawn white stateTicks into
+// StateLevelClear.
+func MazeFlashWhite(stateTicks int) bool {
+	if stateTicks < levelClearFreezeTicks {
+		return false
+	}
+	return ((stateTicks-levelClearFreezeTicks)/mazeFlashTicks)%2 == 0
+}
+
+// clearLevel freezes play once the last pellet is eaten.
+func (s *Sim) clearLevel() {
+	s.popup = nil
+	s.fruit = nil
+	s.setState(StateLevelClear)
+}
+
+// updateLevelClear holds the cleared maze while it flashes, then plays an
+// intermission if one is due or loads the next level.
+func (s *Sim) updateLevelClear() {
+	s.stateTicks++
+	if s.stateTicks < levelClearTicks {
+		return
+	}
+	if number := intermissionAfter(s.level); number > 0 {
+		s.startIntermission(number)
+		return
+	}
+	s.NextLevel()
+}
@@ -43,22 +43,16 @@ const (
 	BaseWidth       = MazeWidthTiles * TileSize  // 224 pixels
 	BaseHeight      = MazeHeightTiles * TileSize // 248 pixels
 
-	// Game speed (base values, adjusted by level)
-	TicksPerSecond    = 30 // Reduced from 60 for better Sixel performance
-	BaseMovementSpeed = 2  // Move every 2 ticks (30 moves per second)
-	BaseGhostSpeed    = 2  // Ghosts same speed
-	AnimationSpeed    = 2  // Animation frame change (every 2 frames = 30fps)
-
-	// Computed values (compatibility)
-	MovementSpeed      = BaseMovementSpeed
-	GhostMovementSpeed = BaseGhostSpeed
+	// Game speed (movement speeds per level are in speeds.go)
+	TicksPerSecond = 30 // Reduced from 60 for better Sixel performance
+	AnimationSpeed = 2  // Animation frame change (every 2 frames = 30fps)
 
 	// Game mechanics
 	InitialLives     = 3
 	PelletScore      = 10
 	PowerPelletScore = 50
-	GhostScore       = 200
-	PowerDuration    = 48 // ticks (~6 seconds at 8 FPS)
+	GhostScore       = 200   // First ghost on a power pellet; doubles for each one after
+	AllGhostsBonus   = 12000 // All four ghosts eaten on every power pellet of a level
 )
 
 // Ghost types
@@ -81,6 +75,16 @@ const (
 	ModeEaten
 )
 
+// Where a ghost is relative to the ghost house
+type HouseState int
+
+const (
+	HouseOutside  HouseState = iota // Roaming the maze
+	HouseWaiting                    // Bouncing inside, waiting to be released
+	HouseLeaving                    // Released, heading out through the door
+	HouseEntering                   // Eyes heading back in to revive
+)
+
 // Game state
 type GameState int
 
@@ -88,7 +92,10 @@ const (
 	StateStart GameState = iota
 	StatePlaying
 	StateGameOver
-	StateWin
+	StateDying        // Death sequence after a ghost catches Pac-Man
+	StateReady        // READY! pause before play starts or resumes
+	StateLevelClear   // Freeze and maze flash after the last pellet is eaten
+	StateIntermission // Cutscene between levels
 )
 
 // Fruit types
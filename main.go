@@ -1,14 +1,62 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"pacman/game"
 )
 
+// version is set at release time via -ldflags "-X main.version=..."
+var version = "dev"
+
 func main() {
-	g, err := game.NewGame()
+	seed := flag.Int64("seed", 0, "random seed for a reproducible run (picked from the clock if not given)")
+	record := flag.String("record", "", "record this run's inputs to a replay `file`")
+	replayPath := flag.String("replay", "", "play back a replay `file` instead of reading the keyboard")
+	upBug := flag.Bool("up-bug", false, "reproduce the arcade overflow bug in Pinky's and Inky's targeting when Pac-Man faces up")
+	extraLife := flag.String("extra-life", "10000", "bonus life `rule`: a score for one life, every:N for a life every N points, or none")
+	display := flag.String("display", game.AutoDisplay, "display `backend`: "+strings.Join(game.DisplayNames(), ", ")+" (auto asks the terminal)")
+	flag.Parse()
+
+	extraLifeRule, err := game.ParseExtraLife(*extraLife)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	opts := game.Options{
+		Rules:      game.Rules{UpOverflowBug: *upBug, ExtraLife: extraLifeRule},
+		Version:    version,
+		RecordPath: *record,
+		Display:    *display,
+	}
+	// Any seed given, 0 included, is used as is
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			opts.Seed = seed
+		}
+	})
+
+	if *replayPath != "" {
+		replay, err := game.LoadReplay(*replayPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading replay: %v\n", err)
+			os.Exit(1)
+		}
+		if replay.SimVersion != game.SimVersion {
+			fmt.Fprintf(os.Stderr, "Error: replay was recorded with sim version %d, this build plays version %d\n", replay.SimVersion, game.SimVersion)
+			os.Exit(1)
+		}
+		if replay.Version != version {
+			fmt.Fprintf(os.Stderr, "Warning: replay was recorded with version %s, this is %s\n", replay.Version, version)
+		}
+		opts.Replay = replay
+	}
+
+	g, err := game.NewGame(opts)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error creating game: %v\n", err)
 		os.Exit(1)